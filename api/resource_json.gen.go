@@ -1524,6 +1524,17 @@ func (this *BackendPolicySpec_BackendTCP) UnmarshalJSON(b []byte) error {
 	return ResourceUnmarshaler.Unmarshal(bytes.NewReader(b), this)
 }
 
+// MarshalJSON is a custom marshaler for BackendPolicySpec_TCPIdleTimeout
+func (this *BackendPolicySpec_TCPIdleTimeout) MarshalJSON() ([]byte, error) {
+	str, err := ResourceMarshaler.MarshalToString(this)
+	return []byte(str), err
+}
+
+// UnmarshalJSON is a custom unmarshaler for BackendPolicySpec_TCPIdleTimeout
+func (this *BackendPolicySpec_TCPIdleTimeout) UnmarshalJSON(b []byte) error {
+	return ResourceUnmarshaler.Unmarshal(bytes.NewReader(b), this)
+}
+
 // MarshalJSON is a custom marshaler for BackendPolicySpec_McpAuthorization
 func (this *BackendPolicySpec_McpAuthorization) MarshalJSON() ([]byte, error) {
 	str, err := ResourceMarshaler.MarshalToString(this)
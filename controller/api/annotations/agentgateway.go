@@ -18,6 +18,16 @@ const MCPServiceHTTPPath = "agentgateway.dev/mcp-path"
 // The value must be a valid Gateway API SectionName.
 const MCPServiceTargetName = "agentgateway.dev/mcp-target-name"
 
+// EndpointPickerPortName is set on an InferencePool to reference the endpoint picker
+// Service port by name rather than number. When set, it takes precedence over
+// endpointPickerRef.port and must name a TCP port of the referenced Service.
+const EndpointPickerPortName = "agentgateway.dev/endpoint-picker-port-name"
+
+// EndpointPickerSNI is set on an InferencePool to override the SNI sent when connecting to
+// the endpoint picker, for example when a shared certificate fronts several pickers. The
+// value must be a valid DNS name. If unset, the SNI is derived from the picker's hostname.
+const EndpointPickerSNI = "agentgateway.dev/endpoint-picker-sni"
+
 // InternalPorts is a comma-separated list of ports whose bind should be internal
 // (routing-only: no OS listener socket, no Service port, no container port). It may
 // be set on a Gateway or a ListenerSet, and may only reference ports defined by that
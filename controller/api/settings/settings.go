@@ -223,6 +223,11 @@ type Settings struct {
 	// is deprecated in v2.1 and will not be supported in v2.2.
 	EnableInferExt bool `split_words:"true"`
 
+	// DisableInferExtEndpointPickerTLS disables the BackendTLS policy generated for each InferencePool's
+	// endpoint picker, which skips certificate verification as the inference extension spec requires.
+	// When set, TLS to the endpoint picker is left to user-provided BackendTLSPolicies.
+	DisableInferExtEndpointPickerTLS bool `split_words:"true" default:"false"`
+
 	// ProxyImageRegistry is the default image registry to use for the proxy image.
 	ProxyImageRegistry string `split_words:"true" default:"cr.agentgateway.dev"`
 	// ProxyImageRepository is the default image repository to use for the proxy image.
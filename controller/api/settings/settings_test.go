@@ -32,6 +32,7 @@ func allEnvVarsSet() map[string]string {
 		"AGW_AGENTGATEWAY_XDS_SERVICE_PORT":            "5678",
 		"AGW_NO_LISTENERS_DUMMY_PORT":                  "8443",
 		"AGW_ENABLE_INFER_EXT":                         "true",
+		"AGW_DISABLE_INFER_EXT_ENDPOINT_PICKER_TLS":    "true",
 		"AGW_LOG_LEVEL":                                "debug",
 		"AGW_DISCOVERY_NAMESPACE_SELECTORS":            `[{"matchLabels":{"app":"test"}}]`,
 		"AGW_ENABLE_BUILTIN_DEFAULT_METRICS":           "true",
@@ -78,6 +79,7 @@ func TestSettings(t *testing.T) {
 				AgentgatewayXdsServicePort:           9978,
 				NoListenersDummyPort:                 443,
 				EnableInferExt:                       false,
+				DisableInferExtEndpointPickerTLS:     false,
 				LogLevel:                             "info",
 				DiscoveryNamespaceSelectors:          "[]",
 				EnableBuiltinDefaultMetrics:          false,
@@ -112,6 +114,7 @@ func TestSettings(t *testing.T) {
 				AgentgatewayXdsServicePort:           5678,
 				NoListenersDummyPort:                 8443,
 				EnableInferExt:                       true,
+				DisableInferExtEndpointPickerTLS:     true,
 				LogLevel:                             "debug",
 				DiscoveryNamespaceSelectors:          `[{"matchLabels":{"app":"test"}}]`,
 				EnableBuiltinDefaultMetrics:          true,
@@ -1,9 +1,11 @@
 package agentgateway
 
 import (
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // +kubebuilder:rbac:groups=agentgateway.dev,resources=agentgatewayparameters,verbs=get;list;watch
@@ -50,6 +52,9 @@ type AgentgatewayParametersList struct {
 // +kubebuilder:validation:XValidation:rule="!has(self.deployment) || !has(self.workload) || !has(self.workload.kind) || self.workload.kind == 'Deployment'",message="deployment overlays are only valid when workload.kind is Deployment or unset"
 // +kubebuilder:validation:XValidation:rule="!has(self.daemonSet) || (has(self.workload) && has(self.workload.kind) && self.workload.kind == 'DaemonSet')",message="daemonSet overlays are only valid when workload.kind is DaemonSet"
 // +kubebuilder:validation:XValidation:rule="!has(self.horizontalPodAutoscaler) || !has(self.workload) || !has(self.workload.kind) || self.workload.kind != 'DaemonSet'",message="horizontalPodAutoscaler is not valid when workload.kind is DaemonSet"
+// +kubebuilder:validation:XValidation:rule="(!has(self.replicas) && !has(self.strategy)) || !has(self.workload) || !has(self.workload.kind) || self.workload.kind == 'Deployment'",message="replicas and strategy are only valid when workload.kind is Deployment or unset"
+// +kubebuilder:validation:XValidation:rule="!has(self.initContainers) || self.initContainers.all(c, c.name != 'agentgateway')",message="initContainers must not use the reserved container name agentgateway"
+// +kubebuilder:validation:XValidation:rule="!has(self.sidecars) || self.sidecars.all(c, c.name != 'agentgateway')",message="sidecars must not use the reserved container name agentgateway"
 type AgentgatewayParametersSpec struct {
 	AgentgatewayParametersConfigs  `json:",inline"`
 	AgentgatewayParametersOverlays `json:",inline"`
@@ -92,6 +97,13 @@ type AgentgatewayParametersLogging struct {
 	// Logging level in standard `RUST_LOG` syntax, for example `info` (the
 	// default), or a comma-separated per-module setting such as
 	// `rmcp=warn,hickory_server::server::server_future=off,typespec_client_core::http::policies::logging=warn`.
+	// Each entry is a level, optionally prefixed with `<target>=`. Levels are
+	// `off`, `error`, `warn`, `info`, `debug`, or `trace` in any case, or `0`
+	// (off) through `5` (trace). Changing the level rolls out the data plane
+	// pods; to adjust it on a running pod, use the admin `/logging` endpoint
+	// instead.
+	// +kubebuilder:validation:MaxLength=1024
+	// +kubebuilder:validation:Pattern=`^([A-Za-z0-9_.:-]+=)?((?i:off|error|warn|info|debug|trace)|[0-5])(,([A-Za-z0-9_.:-]+=)?((?i:off|error|warn|info|debug|trace)|[0-5]))*$`
 	// +optional
 	Level string `json:"level,omitempty"`
 	// Logging output format.
@@ -99,6 +111,57 @@ type AgentgatewayParametersLogging struct {
 	Format AgentgatewayParametersLoggingFormat `json:"format,omitempty"`
 }
 
+// AgentgatewayParametersAdmin configures the proxy admin server. The server is
+// not authenticated, so it always listens on the pod loopback and is only
+// reachable from inside the pod, for example through `kubectl port-forward`.
+type AgentgatewayParametersAdmin struct {
+	// Port the admin server listens on. Defaults to 15000. It must not be
+	// used by a Gateway listener.
+	// +kubebuilder:validation:Minimum=1024
+	// +kubebuilder:validation:Maximum=65535
+	// +kubebuilder:validation:XValidation:rule="self != 15020 && self != 15021",message="ports 15020 and 15021 are reserved for metrics and readiness"
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+}
+
+type AgentgatewayParametersTracing struct {
+	// Headers sent with every OTLP trace export configured by `rawConfig`
+	// `config.tracing`, for example a vendor API key. Values are read from
+	// Secrets in the Gateway's namespace and passed to the proxy through
+	// Secret-backed environment variables, so they are never written to the
+	// proxy ConfigMap. They take precedence over `config.tracing.headers`.
+	// Values must not contain commas.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	SecretHeaders []AgentgatewayParametersTracingSecretHeader `json:"secretHeaders,omitempty"`
+}
+
+type AgentgatewayParametersTracingSecretHeader struct {
+	// Name of the header.
+	// +required
+	Name HTTPHeaderName `json:"name"`
+	// Secret key holding the header value.
+	// +required
+	SecretKeyRef AgentgatewayParametersSecretKeySelector `json:"secretKeyRef"`
+}
+
+type AgentgatewayParametersSecretKeySelector struct {
+	// Name of the Secret in the Gateway's namespace.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +required
+	Name string `json:"name"`
+	// Key in the Secret.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[-._a-zA-Z0-9]+$`
+	// +required
+	Key string `json:"key"`
+}
+
 type AgentgatewayParametersConfigs struct {
 	// `workload` selects the Kubernetes workload kind for the managed Gateway
 	// data plane. If unset, Deployment is used.
@@ -106,15 +169,65 @@ type AgentgatewayParametersConfigs struct {
 	// +optional
 	Workload *AgentgatewayParametersWorkload `json:"workload,omitempty"`
 
+	// Number of data plane replicas. Only applies to Deployment workloads,
+	// and is ignored when a `horizontalPodAutoscaler` is configured so that
+	// the autoscaler owns the replica count. If unset, Kubernetes defaults to
+	// one replica.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// Rollout strategy for the data plane Deployment. When set on the Gateway
+	// parameters, it replaces the GatewayClass strategy. See
+	// https://kubernetes.io/docs/concepts/workloads/controllers/deployment/#strategy
+	// for details.
+	//
+	// +kubebuilder:validation:XValidation:rule="!has(self.type) || self.type in ['RollingUpdate', 'Recreate']",message="strategy type must be RollingUpdate or Recreate"
+	// +kubebuilder:validation:XValidation:rule="!has(self.rollingUpdate) || !has(self.type) || self.type == 'RollingUpdate'",message="rollingUpdate may only be set when type is RollingUpdate"
+	// +kubebuilder:validation:XValidation:rule="!has(self.rollingUpdate) || !has(self.rollingUpdate.maxSurge) || !has(self.rollingUpdate.maxUnavailable) || !((self.rollingUpdate.maxSurge == 0 || self.rollingUpdate.maxSurge == '0%') && (self.rollingUpdate.maxUnavailable == 0 || self.rollingUpdate.maxUnavailable == '0%'))",message="maxSurge and maxUnavailable must not both be zero"
+	// +optional
+	Strategy *appsv1.DeploymentStrategy `json:"strategy,omitempty"`
+
 	// Logging configuration. By default, all logs are set to
 	// `info` level.
 	// +optional
 	Logging *AgentgatewayParametersLogging `json:"logging,omitempty"`
 
+	// Admin server of the proxy, which serves debugging endpoints such as
+	// `/config_dump` (the resolved routes and policies as JSON). The admin
+	// server is unauthenticated and always listens on the pod loopback. When
+	// unset, the proxy keeps its built-in admin server on localhost port 15000.
+	// +optional
+	Admin *AgentgatewayParametersAdmin `json:"admin,omitempty"`
+
+	// Proxy-wide tracing settings that complement `rawConfig`
+	// `config.tracing`.
+	// +optional
+	Tracing *AgentgatewayParametersTracing `json:"tracing,omitempty"`
+
+	// Labels added to every generated resource, including the data plane
+	// pods. Labels managed by agentgateway (such as the
+	// `app.kubernetes.io/name` selector label) cannot be overridden and are
+	// ignored. Labels set on the Gateway's `spec.infrastructure` take
+	// precedence.
+	// +kubebuilder:validation:MaxProperties=64
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations added to every generated resource, including the data
+	// plane pods. Annotations managed by agentgateway (such as the
+	// `checksum/` rollout annotations) cannot be overridden and are ignored.
+	// Annotations set on the Gateway's `spec.infrastructure` take precedence.
+	// +kubebuilder:validation:MaxProperties=64
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+
 	// Raw agentgateway configuration to merge into the generated config file.
 	// This is merged with
 	// configuration derived from typed fields like `logging.format`, and those
-	// typed fields will take precedence.
+	// typed fields will take precedence. When set on both the GatewayClass and
+	// Gateway parameters, the objects are deep merged and Gateway values win.
 	//
 	// Example:
 	//
@@ -181,6 +294,66 @@ type AgentgatewayParametersConfigs struct {
 	// +optional
 	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
 
+	// Security context for the data plane pods, merged field by field over
+	// the defaults:
+	//
+	//	sysctls:
+	//	- name: net.ipv4.ip_unprivileged_port_start
+	//	  value: "0"
+	//
+	// Sysctls are merged by name. Unset fields keep their default, so a
+	// default can only be weakened by setting it explicitly. No seccomp
+	// profile is set by default; set `seccompProfile.type: RuntimeDefault` to
+	// admit the pods under the restricted Pod Security Standard. To remove the
+	// security context entirely, use `$patch: delete` in a workload overlay.
+	//
+	// +optional
+	PodSecurityContext *corev1.PodSecurityContext `json:"podSecurityContext,omitempty"`
+
+	// Security context for the proxy container, merged field by field over
+	// the defaults:
+	//
+	//	allowPrivilegeEscalation: false
+	//	capabilities:
+	//	  drop:
+	//	  - ALL
+	//	readOnlyRootFilesystem: true
+	//	runAsNonRoot: true
+	//	runAsUser: 10101
+	//
+	// `capabilities.add` and `capabilities.drop` are replaced independently.
+	// Unset fields keep their default, so a default can only be weakened by
+	// setting it explicitly.
+	//
+	// +kubebuilder:validation:XValidation:rule="!has(self.runAsUser) || self.runAsUser != 0 || (has(self.runAsNonRoot) && !self.runAsNonRoot)",message="runAsUser 0 requires runAsNonRoot to be explicitly set to false"
+	// +kubebuilder:validation:XValidation:rule="!has(self.privileged) || !self.privileged || (has(self.allowPrivilegeEscalation) && self.allowPrivilegeEscalation)",message="privileged requires allowPrivilegeEscalation to be explicitly set to true"
+	// +optional
+	SecurityContext *corev1.SecurityContext `json:"securityContext,omitempty"`
+
+	// Init containers added to the data plane pods, for example to fetch
+	// secrets before the proxy starts. They run in order before the proxy
+	// container. When set on the Gateway parameters, they replace any init
+	// containers from the GatewayClass parameters.
+	//
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=8
+	// +optional
+	InitContainers []corev1.Container `json:"initContainers,omitempty"`
+
+	// Additional containers run alongside the proxy container in the data
+	// plane pods, for example a logging agent. The proxy container itself
+	// cannot be replaced. When set on the Gateway parameters, they replace any
+	// sidecars from the GatewayClass parameters. Sidecar names must not
+	// collide with init container names; the Gateway is not provisioned
+	// otherwise.
+	//
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=8
+	// +optional
+	Sidecars []corev1.Container `json:"sidecars,omitempty"`
+
 	// Shutdown delay configuration. How graceful planned or unplanned data
 	// plane changes happen is in tension with how quickly rollouts of the data
 	// plane complete. How long a data plane pod must wait for shutdown to be
@@ -190,6 +363,77 @@ type AgentgatewayParametersConfigs struct {
 	// +optional
 	Shutdown *ShutdownSpec `json:"shutdown,omitempty"`
 
+	// Node labels the data plane pods must match to be scheduled. When set on
+	// both the GatewayClass and Gateway parameters, the maps are merged and
+	// Gateway values win. See
+	// https://kubernetes.io/docs/concepts/scheduling-eviction/assign-pod-node/#nodeselector
+	// for details.
+	//
+	// +kubebuilder:validation:MaxProperties=64
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Tolerations for the data plane pods. When set on the Gateway parameters,
+	// they replace any tolerations from the GatewayClass parameters. See
+	// https://kubernetes.io/docs/concepts/scheduling-eviction/taint-and-toleration/
+	// for details.
+	//
+	// +kubebuilder:validation:MaxItems=32
+	// +kubebuilder:validation:XValidation:rule="self.all(t, !has(t.operator) || t.operator in ['Exists', 'Equal'])",message="toleration operator must be Exists or Equal"
+	// +kubebuilder:validation:XValidation:rule="self.all(t, !has(t.effect) || t.effect in ['NoSchedule', 'PreferNoSchedule', 'NoExecute'])",message="toleration effect must be NoSchedule, PreferNoSchedule or NoExecute"
+	// +kubebuilder:validation:XValidation:rule="self.all(t, !has(t.operator) || t.operator != 'Exists' || !has(t.value) || t.value == '')",message="toleration value must be empty when operator is Exists"
+	// +kubebuilder:validation:XValidation:rule="self.all(t, (has(t.key) && t.key != '') || (has(t.operator) && t.operator == 'Exists'))",message="toleration operator must be Exists when key is empty"
+	// +kubebuilder:validation:XValidation:rule="self.all(t, !has(t.tolerationSeconds) || (has(t.effect) && t.effect == 'NoExecute'))",message="tolerationSeconds may only be set when effect is NoExecute"
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// Name of the `PriorityClass` for the data plane pods, for example
+	// `system-cluster-critical` to keep them from being evicted before less
+	// critical workloads under node pressure. See
+	// https://kubernetes.io/docs/concepts/scheduling-eviction/pod-priority-preemption/
+	// for details.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	// +optional
+	PriorityClassName *string `json:"priorityClassName,omitempty"`
+
+	// Name of the `ServiceAccount` the data plane pods run as. It must already
+	// exist in the Gateway's namespace. When unset, a `ServiceAccount` named
+	// after the Gateway is created and used.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	// +optional
+	ServiceAccountName *string `json:"serviceAccountName,omitempty"`
+
+	// Secrets in the Gateway's namespace used to pull the data plane images,
+	// including any init containers and sidecars. When set on the Gateway
+	// parameters, they replace any pull secrets from the GatewayClass
+	// parameters.
+	//
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	// +optional
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+
+	// Settings for the generated data plane `Service`. The `service` overlay,
+	// if any, is applied on top of the result.
+	//
+	// +optional
+	ServiceConfig *AgentgatewayParametersServiceConfig `json:"serviceConfig,omitempty"`
+
+	// Creates a `PodDisruptionBudget` for the generated data plane pods.
+	// Exactly one of `minAvailable` or `maxUnavailable` must be set. When set
+	// on the Gateway parameters, it replaces the GatewayClass setting. The
+	// `podDisruptionBudget` overlay, if any, is applied on top of the result.
+	//
+	// +optional
+	PodDisruptionBudgetConfig *AgentgatewayParametersPodDisruptionBudgetConfig `json:"podDisruptionBudgetConfig,omitempty"`
+
 	// Istio integration settings. If enabled, agentgateway can natively connect to Istio-enabled pods with mTLS.
 	//
 	// +optional
@@ -258,6 +502,46 @@ type IstioSpec struct {
 	Network string `json:"network,omitempty"`
 }
 
+// +kubebuilder:validation:XValidation:rule="!has(self.loadBalancerClass) || !has(self.type) || self.type == 'LoadBalancer'",message="loadBalancerClass may only be set when type is LoadBalancer"
+type AgentgatewayParametersServiceConfig struct {
+	// Type of the generated `Service`. Defaults to `LoadBalancer`.
+	//
+	// +kubebuilder:validation:Enum=ClusterIP;NodePort;LoadBalancer
+	// +optional
+	Type *corev1.ServiceType `json:"type,omitempty"`
+
+	// Load balancer implementation to use, for example `service.k8s.aws/nlb`.
+	// Only valid when `type` is `LoadBalancer`.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +optional
+	LoadBalancerClass *string `json:"loadBalancerClass,omitempty"`
+
+	// Annotations added to the generated `Service` only, such as cloud
+	// provider load balancer settings. These take precedence over
+	// `annotations` and the Gateway's `spec.infrastructure.annotations`.
+	//
+	// +kubebuilder:validation:MaxProperties=64
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// +kubebuilder:validation:XValidation:rule="has(self.minAvailable) != has(self.maxUnavailable)",message="exactly one of minAvailable or maxUnavailable must be set"
+type AgentgatewayParametersPodDisruptionBudgetConfig struct {
+	// Number or percentage of data plane pods that must remain available
+	// during a voluntary disruption.
+	//
+	// +optional
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+
+	// Number or percentage of data plane pods that may be unavailable during
+	// a voluntary disruption.
+	//
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
 // +kubebuilder:validation:XValidation:rule="self.min <= self.max",message="The 'min' value must be less than or equal to the 'max' value."
 type ShutdownSpec struct {
 	// Minimum time (in seconds) to wait before allowing Agentgateway to
@@ -301,7 +585,8 @@ type AgentgatewayParametersOverlays struct {
 	ServiceAccount *KubernetesResourceOverlay `json:"serviceAccount,omitempty"`
 
 	// Creates a `PodDisruptionBudget` for the
-	// agentgateway proxy. If absent, no PDB is created. If present, a PDB is
+	// agentgateway proxy. If absent, no PDB is created unless
+	// `podDisruptionBudgetConfig` is set. If present, a PDB is
 	// created with its selector automatically configured to target the selected
 	// generated workload. The `metadata` and `spec` fields from this overlay are
 	// applied to the generated PDB.
@@ -320,23 +605,32 @@ type AgentgatewayParametersOverlays struct {
 // Container image settings. See https://kubernetes.io/docs/concepts/containers/images
 // for details.
 type Image struct {
-	// Image registry.
+	// Image registry, optionally with a port and path, such as
+	// `registry.example.com:5000/mirror`. An empty value omits the registry.
 	//
+	// +kubebuilder:validation:MaxLength=255
+	// +kubebuilder:validation:Pattern=`^([a-zA-Z0-9]([a-zA-Z0-9.-]*[a-zA-Z0-9])?(:[0-9]+)?(/[a-z0-9]+([._-][a-z0-9]+)*)*)?$`
 	// +optional
 	Registry *string `json:"registry,omitempty"`
 
 	// Image repository.
 	//
+	// +kubebuilder:validation:MaxLength=255
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]+([._-][a-z0-9]+)*(/[a-z0-9]+([._-][a-z0-9]+)*)*$`
 	// +optional
 	Repository *string `json:"repository,omitempty"`
 
-	// Image tag.
+	// Image tag. An empty value omits the tag, for example when pinning a
+	// digest.
 	//
+	// +kubebuilder:validation:Pattern=`^([A-Za-z0-9_][A-Za-z0-9_.-]{0,127})?$`
 	// +optional
 	Tag *string `json:"tag,omitempty"`
 
 	// Image digest, such as `sha256:12345...`.
 	//
+	// +kubebuilder:validation:MaxLength=255
+	// +kubebuilder:validation:Pattern=`^([A-Za-z][A-Za-z0-9]*([-_+.][A-Za-z][A-Za-z0-9]*)*:[0-9a-fA-F]{32,})?$`
 	// +optional
 	Digest *string `json:"digest,omitempty"`
 
@@ -344,6 +638,7 @@ type Image struct {
 	// https://kubernetes.io/docs/concepts/containers/images/#image-pull-policy
 	// for details.
 	//
+	// +kubebuilder:validation:Enum=Always;IfNotPresent;Never
 	// +optional
 	PullPolicy *corev1.PullPolicy `json:"pullPolicy,omitempty"`
 }
@@ -63,10 +63,14 @@ type AgentgatewayPolicyList struct {
 // +kubebuilder:validation:XValidation:rule="has(self.frontend) && (has(self.frontend.tcp) || has(self.frontend.networkAuthorization) || has(self.frontend.tls) || has(self.frontend.http) || has(self.frontend.proxyProtocol) || has(self.frontend.connect)) && has(self.targetSelectors) ? self.targetSelectors.all(t, !has(t.sectionName)) : true",message="frontend tcp, networkAuthorization, tls, http, proxyProtocol, and connect policies may only target a Gateway or port, not a listener (sectionName)"
 // +kubebuilder:validation:XValidation:rule="has(self.targetRefs) && self.targetRefs.exists(t, has(t.port)) ? (has(self.frontend) && !has(self.traffic) && !has(self.backend)) : true",message="port may only be set on frontend-only policies (not traffic or backend)"
 // +kubebuilder:validation:XValidation:rule="has(self.targetSelectors) && self.targetSelectors.exists(t, has(t.port)) ? (has(self.frontend) && !has(self.traffic) && !has(self.backend)) : true",message="port may only be set on frontend-only policies (not traffic or backend)"
-// +kubebuilder:validation:XValidation:rule="has(self.traffic) && has(self.targetRefs) ? self.targetRefs.all(t, t.kind in ['Gateway', 'HTTPRoute', 'GRPCRoute', 'ListenerSet', 'InferencePool']) : true",message="the 'traffic' field can only target a Gateway, ListenerSet, GRPCRoute, HTTPRoute, or InferencePool"
-// +kubebuilder:validation:XValidation:rule="has(self.traffic) && has(self.targetSelectors) ? self.targetSelectors.all(t, t.kind in ['Gateway', 'HTTPRoute', 'GRPCRoute', 'ListenerSet', 'InferencePool']) : true",message="the 'traffic' field can only target a Gateway, ListenerSet, GRPCRoute, HTTPRoute, or InferencePool"
+// +kubebuilder:validation:XValidation:rule="has(self.traffic) && has(self.targetRefs) ? self.targetRefs.all(t, t.kind in ['Gateway', 'HTTPRoute', 'GRPCRoute', 'TCPRoute', 'ListenerSet', 'InferencePool']) : true",message="the 'traffic' field can only target a Gateway, ListenerSet, GRPCRoute, HTTPRoute, TCPRoute, or InferencePool"
+// +kubebuilder:validation:XValidation:rule="has(self.traffic) && has(self.targetSelectors) ? self.targetSelectors.all(t, t.kind in ['Gateway', 'HTTPRoute', 'GRPCRoute', 'TCPRoute', 'ListenerSet', 'InferencePool']) : true",message="the 'traffic' field can only target a Gateway, ListenerSet, GRPCRoute, HTTPRoute, TCPRoute, or InferencePool"
+// +kubebuilder:validation:XValidation:rule="has(self.traffic) && has(self.traffic.defaultDeny) && has(self.targetRefs) ? self.targetRefs.all(t, t.kind == 'Gateway') : true",message="traffic.defaultDeny can only target a Gateway"
+// +kubebuilder:validation:XValidation:rule="has(self.traffic) && has(self.traffic.defaultDeny) && has(self.targetSelectors) ? self.targetSelectors.all(t, t.kind == 'Gateway') : true",message="traffic.defaultDeny can only target a Gateway"
 // +kubebuilder:validation:XValidation:rule="has(self.targetRefs) && has(self.traffic) && has(self.traffic.phase) && self.traffic.phase == 'PreRouting' ? self.targetRefs.all(t, t.kind in ['Gateway', 'ListenerSet']) : true",message="the 'traffic.phase=PreRouting' field can only target a Gateway or ListenerSet"
 // +kubebuilder:validation:XValidation:rule="has(self.targetSelectors) && has(self.traffic) && has(self.traffic.phase) && self.traffic.phase == 'PreRouting' ? self.targetSelectors.all(t, t.kind in ['Gateway', 'ListenerSet']) : true",message="the 'traffic.phase=PreRouting' field can only target a Gateway or ListenerSet"
+// +kubebuilder:validation:XValidation:rule="has(self.backend) && has(self.backend.tcp) && has(self.backend.tcp.idleTimeout) && has(self.targetRefs) ? self.targetRefs.all(t, !(t.kind in ['HTTPRoute', 'GRPCRoute'])) : true",message="backend.tcp.idleTimeout only applies to TCPRoute connections and may not target an HTTPRoute or GRPCRoute"
+// +kubebuilder:validation:XValidation:rule="has(self.backend) && has(self.backend.tcp) && has(self.backend.tcp.idleTimeout) && has(self.targetSelectors) ? self.targetSelectors.all(t, !(t.kind in ['HTTPRoute', 'GRPCRoute'])) : true",message="backend.tcp.idleTimeout only applies to TCPRoute connections and may not target an HTTPRoute or GRPCRoute"
 type AgentgatewayPolicySpec struct {
 	// Target resources to attach the
 	// policy to.
@@ -74,7 +78,7 @@ type AgentgatewayPolicySpec struct {
 	// +listType=atomic
 	// +kubebuilder:validation:MinItems=1
 	// +kubebuilder:validation:MaxItems=16
-	// +kubebuilder:validation:XValidation:rule="self.all(r, (r.kind == 'Service' && r.group == '') || (r.kind == 'AgentgatewayBackend' && r.group == 'agentgateway.dev') || (r.kind in ['Gateway', 'HTTPRoute', 'GRPCRoute'] && r.group == 'gateway.networking.k8s.io') || (r.kind == 'ListenerSet' && r.group == 'gateway.networking.k8s.io') || (r.kind == 'InferencePool' && r.group == 'inference.networking.k8s.io'))",message="targetRefs may only reference Gateway, HTTPRoute, GRPCRoute, ListenerSet, Service, AgentgatewayBackend, or InferencePool resources"
+	// +kubebuilder:validation:XValidation:rule="self.all(r, (r.kind == 'Service' && r.group == '') || (r.kind == 'AgentgatewayBackend' && r.group == 'agentgateway.dev') || (r.kind in ['Gateway', 'HTTPRoute', 'GRPCRoute', 'TCPRoute'] && r.group == 'gateway.networking.k8s.io') || (r.kind == 'ListenerSet' && r.group == 'gateway.networking.k8s.io') || (r.kind == 'InferencePool' && r.group == 'inference.networking.k8s.io'))",message="targetRefs may only reference Gateway, HTTPRoute, GRPCRoute, TCPRoute, ListenerSet, Service, AgentgatewayBackend, or InferencePool resources"
 	// +kubebuilder:validation:XValidation:message="Only one Kind of targetRef can be set on one policy",rule="self.all(l1, !self.exists(l2, l1.kind != l2.kind))"
 	// +optional
 	TargetRefs []LocalPolicyTargetReferenceWithSectionName `json:"targetRefs,omitempty"`
@@ -82,11 +86,23 @@ type AgentgatewayPolicySpec struct {
 	// Target selectors used to select resources to attach the policy to.
 	// +kubebuilder:validation:MinItems=1
 	// +kubebuilder:validation:MaxItems=16
-	// +kubebuilder:validation:XValidation:rule="self.all(r, (r.kind == 'Service' && r.group == '') || (r.kind == 'AgentgatewayBackend' && r.group == 'agentgateway.dev') || (r.kind in ['Gateway', 'HTTPRoute', 'GRPCRoute'] && r.group == 'gateway.networking.k8s.io') || (r.kind == 'ListenerSet' && r.group == 'gateway.networking.k8s.io') || (r.kind == 'InferencePool' && r.group == 'inference.networking.k8s.io'))",message="targetRefs may only reference Gateway, HTTPRoute, GRPCRoute, ListenerSet, Service, AgentgatewayBackend, or InferencePool resources"
+	// +kubebuilder:validation:XValidation:rule="self.all(r, (r.kind == 'Service' && r.group == '') || (r.kind == 'AgentgatewayBackend' && r.group == 'agentgateway.dev') || (r.kind in ['Gateway', 'HTTPRoute', 'GRPCRoute', 'TCPRoute'] && r.group == 'gateway.networking.k8s.io') || (r.kind == 'ListenerSet' && r.group == 'gateway.networking.k8s.io') || (r.kind == 'InferencePool' && r.group == 'inference.networking.k8s.io'))",message="targetRefs may only reference Gateway, HTTPRoute, GRPCRoute, TCPRoute, ListenerSet, Service, AgentgatewayBackend, or InferencePool resources"
 	// +kubebuilder:validation:XValidation:message="Only one Kind of targetRef can be set on one policy",rule="self.all(l1, !self.exists(l2, l1.kind != l2.kind))"
 	// +optional
 	TargetSelectors []LocalPolicyTargetSelectorWithSectionName `json:"targetSelectors,omitempty"`
 
+	// Whether the policy is applied. When `false`, the policy is kept but
+	// produces no configuration for any of its targets, and its status reports
+	// it as disabled. Defaults to `true`.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Time window in which the policy is applied. Outside of the window the
+	// policy produces no configuration for any of its targets, and its status
+	// reports it as inactive. If unset, the policy is always active.
+	// +optional
+	ActiveSchedule *PolicySchedule `json:"activeSchedule,omitempty"`
+
 	// Policy merge and conflict resolution strategy.
 	//
 	// Strategy settings apply to the policy object as a whole. Individual strategy fields may
@@ -143,6 +159,27 @@ type AgentgatewayPolicySpec struct {
 	Backend *BackendFull `json:"backend,omitempty"`
 }
 
+// IsEnabled reports whether the policy should be applied to its targets.
+func (s *AgentgatewayPolicySpec) IsEnabled() bool {
+	return s.Enabled == nil || *s.Enabled
+}
+
+// Time window in which a policy is applied.
+//
+// +kubebuilder:validation:AtLeastOneFieldSet
+// +kubebuilder:validation:XValidation:rule="has(self.start) && has(self.end) ? self.start < self.end : true",message="start must be before end"
+type PolicySchedule struct {
+	// Time from which the policy is applied, in RFC 3339 format. If unset, the
+	// policy is active until `end`.
+	// +optional
+	Start *metav1.Time `json:"start,omitempty"`
+
+	// Time from which the policy is no longer applied, in RFC 3339 format. If
+	// unset, the policy stays active once `start` has passed.
+	// +optional
+	End *metav1.Time `json:"end,omitempty"`
+}
+
 type PolicyStrategy struct {
 	// Controls whether less-specific traffic policies prevent more-specific traffic policies
 	// from contributing to the effective policy.
@@ -653,7 +690,8 @@ type FrontendHTTP struct {
 	// Maximum HTTP body size that will be buffered
 	// into memory.
 	// Bodies will only be buffered for policies which require buffering.
-	// If unset, this defaults to `2mb`.
+	// If unset, this defaults to `2mb`. The maximum is `1Gi`.
+	// +kubebuilder:validation:XValidation:rule="!quantity(string(self)).isGreaterThan(quantity('1Gi'))",message="maxBufferSize must be at most 1Gi"
 	// +optional
 	MaxBufferSize *ByteSize `json:"maxBufferSize,omitempty"`
 
@@ -682,10 +720,14 @@ type FrontendHTTP struct {
 
 	// Initial window size for stream-level flow
 	// control for received data.
+	// The maximum is 2147483647 bytes, the largest window allowed by HTTP/2.
+	// +kubebuilder:validation:XValidation:rule="!quantity(string(self)).isGreaterThan(quantity('2147483647'))",message="http2WindowSize must be at most 2147483647 bytes"
 	// +optional
 	HTTP2WindowSize *ByteSize `json:"http2WindowSize,omitempty"`
 	// Initial window size for
 	// connection-level flow control for received data.
+	// The maximum is 2147483647 bytes, the largest window allowed by HTTP/2.
+	// +kubebuilder:validation:XValidation:rule="!quantity(string(self)).isGreaterThan(quantity('2147483647'))",message="http2ConnectionWindowSize must be at most 2147483647 bytes"
 	// +optional
 	HTTP2ConnectionWindowSize *ByteSize `json:"http2ConnectionWindowSize,omitempty"`
 	// Maximum frame size to use.
@@ -696,7 +738,8 @@ type FrontendHTTP struct {
 	HTTP2FrameSize *ByteSize `json:"http2FrameSize,omitempty"`
 	// Maximum aggregate size of decoded HTTP/2
 	// request headers.
-	// If unset, this defaults to `16Ki`.
+	// If unset, this defaults to `16Ki`. The maximum is `16Mi`.
+	// +kubebuilder:validation:XValidation:rule="!quantity(string(self)).isGreaterThan(quantity('16Mi'))",message="http2MaxHeaderSize must be at most 16Mi"
 	// +optional
 	HTTP2MaxHeaderSize *ByteSize `json:"http2MaxHeaderSize,omitempty"`
 	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
@@ -717,6 +760,9 @@ type FrontendHTTP struct {
 }
 
 // +kubebuilder:validation:AtLeastOneFieldSet
+// +kubebuilder:validation:XValidation:rule="!has(self.minProtocolVersion) || !has(self.maxProtocolVersion) || self.minProtocolVersion <= self.maxProtocolVersion",message="minProtocolVersion must not be greater than maxProtocolVersion"
+// +kubebuilder:validation:XValidation:rule="!has(self.cipherSuites) || !has(self.minProtocolVersion) || self.minProtocolVersion != '1.3' || self.cipherSuites.all(c, c.startsWith('TLS13_'))",message="cipherSuites may only contain TLS 1.3 cipher suites when minProtocolVersion is 1.3"
+// +kubebuilder:validation:XValidation:rule="!has(self.cipherSuites) || !has(self.maxProtocolVersion) || self.maxProtocolVersion != '1.2' || self.cipherSuites.all(c, !c.startsWith('TLS13_'))",message="cipherSuites may not contain TLS 1.3 cipher suites when maxProtocolVersion is 1.2"
 type FrontendTLS struct {
 	// Deadline for a TLS handshake to
 	// complete. If unset, this defaults to `15s`.
@@ -728,10 +774,15 @@ type FrontendTLS struct {
 	// Application-Layer Protocol Negotiation (`ALPN`)
 	// value to use in the TLS handshake.
 	//
-	// If not present, defaults to `["h2", "http/1.1"]`.
+	// If not present, defaults to `["h2", "http/1.1"]`. Protocols are advertised
+	// in the order listed, so the first entry is preferred. Each entry must be a
+	// protocol ID from the IANA TLS ALPN Protocol IDs registry, such as `h2` or
+	// `http/1.1`.
 	//
 	// +kubebuilder:validation:MinItems=1
 	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:rule="self.all(p, p in ['http/0.9', 'http/1.0', 'http/1.1', 'spdy/1', 'spdy/2', 'spdy/3', 'stun.turn', 'stun.nat-discovery', 'h2', 'h2c', 'webrtc', 'c-webrtc', 'ftp', 'imap', 'pop3', 'managesieve', 'coap', 'xmpp-client', 'xmpp-server', 'acme-tls/1', 'mqtt', 'dot', 'ntske/1', 'sunrpc', 'h3', 'smb', 'irc', 'nntp', 'nnsp', 'doq', 'sip/2', 'tds/8.0', 'dicom', 'postgresql', 'radius/1.0', 'radius/1.1'])",message="alpnProtocols entries must be registered ALPN protocol IDs"
+	// +kubebuilder:validation:XValidation:rule="self.all(p, self.exists_one(q, q == p))",message="alpnProtocols must not contain duplicates"
 	// +optional
 	AlpnProtocols *[]TinyString `json:"alpnProtocols,omitempty"`
 
@@ -747,6 +798,10 @@ type FrontendTLS struct {
 	// The value is a comma-separated list of cipher suites, for example
 	// `TLS13_AES_256_GCM_SHA384,TLS13_AES_128_GCM_SHA256`.
 	// Use this in the TLS options field of a TLS listener.
+	//
+	// Cipher suites must be usable with the allowed protocol versions: only
+	// `TLS13_` suites when `minProtocolVersion` is `1.3`, and no `TLS13_` suites
+	// when `maxProtocolVersion` is `1.2`.
 	// +optional
 	CipherSuites []CipherSuite `json:"cipherSuites,omitempty"`
 
@@ -835,6 +890,9 @@ const (
 	PolicyPhasePostRouting PolicyPhase = "PostRouting"
 )
 
+// +kubebuilder:validation:XValidation:rule="!(has(self.maxRequestBytes) && has(self.buffer) && has(self.buffer.request))",message="maxRequestBytes may not be combined with buffer.request"
+// +kubebuilder:validation:XValidation:rule="!(has(self.metadataHeaders) && has(self.transformation))",message="metadataHeaders may not be combined with transformation"
+// +kubebuilder:validation:XValidation:rule="!has(self.metadataHeaders) || !self.metadataHeaders.exists(h, has(h.valueFrom.claim)) || has(self.jwtAuthentication)",message="metadataHeaders with a claim source require jwtAuthentication"
 // +kubebuilder:validation:IfThenOnlyFields:if="has(self.phase) && self.phase == 'PreRouting'",fields=phase;authorization;transformation;extProc;extAuth;jwtAuthentication;basicAuthentication;apiKeyAuthentication;cors,message="phase PreRouting only supports extAuth, authorization, transformation, extProc, jwtAuthentication, basicAuthentication, apiKeyAuthentication and cors"
 type Traffic struct {
 	// The phase to apply the traffic policy to. If the phase is `PreRouting`,
@@ -902,9 +960,20 @@ type Traffic struct {
 	// +optional
 	HostnameRewrite *HostnameRewrite `json:"hostRewrite,omitempty"`
 
+	// Redirects plaintext HTTP requests to HTTPS.
+	// It is intended to be attached to a `Gateway` or `ListenerSet` listener that accepts
+	// plaintext HTTP, by setting `sectionName` to the listener name. If any targeted listener
+	// does not accept plaintext HTTP, the redirect would loop, so it is not applied to that
+	// target and a warning is reported in the policy status. Without `sectionName`, a
+	// `Gateway` target includes the listeners of its `ListenerSets`.
+	// +optional
+	HTTPSRedirect *HTTPSRedirect `json:"httpsRedirect,omitempty"`
+
 	// Request timeouts.
-	// It is applicable to `HTTPRoute` resources and ignored for other targeted
-	// kinds.
+	// It is applicable to `HTTPRoute` resources, and to `Gateway` and `ListenerSet` resources
+	// where it acts as a default for every route below them. Timeouts set on a more-specific
+	// target, such as a route, take precedence unless the gateway-level policy uses the
+	// `Override` inheritance strategy. It is ignored for other targeted kinds.
 	// +optional
 	Timeouts *Timeouts `json:"timeouts,omitempty"`
 
@@ -919,6 +988,13 @@ type Traffic struct {
 	// +optional
 	Authorization *Authorization `json:"authorization,omitempty"`
 
+	// Denies every request with a 403 unless an `Allow` authorization rule,
+	// typically from a route-level policy, explicitly permits it. This may only
+	// be set on a policy targeting a `Gateway`, and composes with other
+	// authorization rules as a gateway-wide allow rule that never matches.
+	// +optional
+	DefaultDeny *bool `json:"defaultDeny,omitempty"`
+
 	// Authenticates users based on JWT tokens.
 	// +optional
 	JWTAuthentication *JWTAuthentication `json:"jwtAuthentication,omitempty"`
@@ -947,12 +1023,91 @@ type Traffic struct {
 	// +optional
 	Buffer *Buffer `json:"buffer,omitempty"`
 
+	// Maximum size of the request body. Requests with a larger body are rejected with a
+	// `413 Payload Too Large` response.
+	//
+	// The limit is enforced by buffering the request body up to the limit, so it may not be
+	// combined with `buffer.request`. It applies to the body as sent by the client: for bodies
+	// sent with a `Content-Encoding`, such as `gzip`, the compressed size is limited, not the
+	// decompressed size.
+	//
+	// The value must be positive.
+	// +optional
+	MaxRequestBytes *ByteSize `json:"maxRequestBytes,omitempty"`
+
 	// Injects artificial latency before forwarding requests, for
 	// fault-injection testing.
 	// +optional
 	Delay *Delay `json:"delay,omitempty"`
+
+	// Sets request headers sent to the backend from a JWT claim or an attribute
+	// of the matched route, for example to identify the tenant or model for
+	// billing. Headers sourced from a claim require `jwtAuthentication` in the
+	// same policy.
+	//
+	// These headers are applied as a request transformation, so they may not be
+	// combined with `transformation`.
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +optional
+	MetadataHeaders []MetadataHeader `json:"metadataHeaders,omitempty"`
+
+	// Settings for connections proxied by a `TCPRoute`.
+	// It is only applicable to `TCPRoute` targets, and a policy targeting a
+	// `TCPRoute` may not set any other traffic settings.
+	// +optional
+	TCP *TrafficTCP `json:"tcp,omitempty"`
+}
+
+// Idle timeout for TCP traffic.
+type TrafficTCP struct {
+	// Time a connection to the backend may be idle before it is closed. This
+	// overrides a backend `tcp.idleTimeout`; the backend keepalive and connect
+	// timeout settings still apply.
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1s')",message="idleTimeout must be at least 1 second"
+	// +required
+	IdleTimeout *metav1.Duration `json:"idleTimeout"`
+}
+
+// Request header computed from request metadata.
+type MetadataHeader struct {
+	// Name of the header to set.
+	// +required
+	Name gwv1.HTTPHeaderName `json:"name"`
+
+	// Source of the header value.
+	// +required
+	ValueFrom MetadataHeaderSource `json:"valueFrom"`
 }
 
+// +kubebuilder:validation:ExactlyOneOf=claim;routeAttribute
+type MetadataHeaderSource struct {
+	// Name of a claim in the validated JWT. The header is not set if the claim
+	// is missing.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9_.:/-]+$`
+	// +optional
+	Claim *string `json:"claim,omitempty"`
+
+	// Attribute of the matched route or its selected backend.
+	// +optional
+	RouteAttribute *RouteAttribute `json:"routeAttribute,omitempty"`
+}
+
+// +k8s:enum
+type RouteAttribute string
+
+const (
+	RouteAttributeName        RouteAttribute = "Name"
+	RouteAttributeNamespace   RouteAttribute = "Namespace"
+	RouteAttributeRule        RouteAttribute = "Rule"
+	RouteAttributeBackendName RouteAttribute = "BackendName"
+)
+
 // Direct response policy.
 //
 // +kubebuilder:validation:XValidation:rule="!(has(self.body) && has(self.bodyExpression))",message="body and bodyExpression may not both be set"
@@ -1090,6 +1245,7 @@ type AuthorizationCookieLocation struct {
 
 // +kubebuilder:validation:XValidation:rule="!has(self.mcp) || size(self.providers) == 1",message="jwtAuthentication.mcp requires exactly one provider"
 // +kubebuilder:validation:XValidation:rule="!has(self.mcp) || !has(self.mode) || self.mode == 'Strict'",message="jwtAuthentication.mcp requires mode Strict"
+// +kubebuilder:validation:XValidation:rule="!(has(self.location) && has(self.tokenSources))",message="location and tokenSources may not both be set"
 type JWTAuthentication struct {
 	// Validation mode for JWT authentication.
 	// +kubebuilder:default=Strict
@@ -1106,6 +1262,23 @@ type JWTAuthentication struct {
 	// +optional
 	Location *AuthorizationExtractionLocation `json:"location,omitempty"`
 
+	// Ordered list of headers, cookies, or query parameters to read JWT credentials from.
+	// Each source is tried in order, and the first one present on the request is validated.
+	// This is useful for clients, such as browsers, that cannot always set the `Authorization` header.
+	// May not be combined with `location`.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=8
+	// +optional
+	TokenSources []AuthorizationLocation `json:"tokenSources,omitempty"`
+
+	// Forwards the validated token to the upstream backend. Useful when the backend
+	// re-validates the token itself. When `false`, the token is removed from the location
+	// it was read from after validation; other credentials on the request are left untouched.
+	// Defaults to `false`.
+	// +optional
+	// +kubebuilder:default=false
+	ForwardToken bool `json:"forwardToken,omitempty"`
+
 	// Enables MCP OAuth metadata endpoint handling
 	// and MCP-specific authentication behavior on top of standard JWT validation.
 	// When set, the gateway will serve the MCP OAuth metadata discovery endpoints.
@@ -1126,6 +1299,14 @@ type JWTProvider struct {
 	// +kubebuilder:validation:MaxItems=64
 	// +optional
 	Audiences []string `json:"audiences,omitempty"`
+	// Claims the JWT must carry, checked after signature validation, such as
+	// `azp` or a custom `role`. Keys are claim names. An empty value only
+	// requires the claim to be present; otherwise the claim must be a string
+	// equal to the value. Tokens missing a required claim are rejected.
+	// +kubebuilder:validation:MaxProperties=16
+	// +kubebuilder:validation:XValidation:rule="self.all(k, size(k) > 0)",message="requiredClaims keys must not be empty"
+	// +optional
+	RequiredClaims map[string]string `json:"requiredClaims,omitempty"`
 	// JSON Web Key Set used to validate the signature of the
 	// JWT.
 	// +required
@@ -1150,19 +1331,39 @@ type JWTMCPConfig struct {
 }
 
 // +kubebuilder:validation:ExactlyOneOf=remote;inline
+// +kubebuilder:validation:XValidation:rule="!has(self.format) || has(self.inline)",message="format may only be set with inline"
 type JWKS struct {
 	// How to reach the JSON Web Key Set from a remote
 	// address.
 	// +optional
 	Remote *RemoteJWKS `json:"remote,omitempty"`
-	// Inline JSON Web Key Set used to validate the
-	// signature of the JWT.
+	// Inline keys used to validate the signature of the JWT. By default this is
+	// a JSON Web Key Set; see `format` for other encodings.
 	// +kubebuilder:validation:MinLength=2
 	// +kubebuilder:validation:MaxLength=65536
 	// +optional
 	Inline *string `json:"inline,omitempty"`
+	// Encoding of `inline`. PEM public keys and X.509 certificates are
+	// converted to a JSON Web Key Set. If unset, the format is detected from
+	// the content: PEM `CERTIFICATE` blocks are read as X509, other PEM blocks
+	// as PEM, and anything else as JWKSet.
+	// +optional
+	Format *JWKSFormat `json:"format,omitempty"`
 }
 
+// +kubebuilder:validation:Enum=JWKSet;PEM;X509
+type JWKSFormat string
+
+const (
+	// A JSON Web Key Set document.
+	JWKSFormatJWKSet JWKSFormat = "JWKSet"
+	// One or more PEM encoded `PUBLIC KEY` or `RSA PUBLIC KEY` blocks.
+	JWKSFormatPEM JWKSFormat = "PEM"
+	// One or more PEM encoded X.509 `CERTIFICATE` blocks. The certificate
+	// public keys are used; the certificates are not validated.
+	JWKSFormatX509 JWKSFormat = "X509"
+)
+
 type RemoteJWKS struct {
 	// Path to the IdP `jwks` endpoint, relative to the root, commonly
 	// `".well-known/jwks.json"`.
@@ -1316,7 +1517,7 @@ type APIKeyAuthentication struct {
 	//	      }
 	//	    }
 	// +optional
-	SecretRef *LocalSecretObjectRef `json:"secretRef,omitempty"`
+	SecretRef *SecretObjectRef `json:"secretRef,omitempty"`
 
 	// Selects multiple Kubernetes `Secret` resources
 	// containing API keys. It is Secret-only; use `secretRef` for other
@@ -1814,7 +2015,7 @@ type OAuthClientAuth struct {
 	// `secretRef.key`. When omitted, client_id is sent without a secret, which
 	// is only valid with ClientSecretPost.
 	// +optional
-	SecretRef *LocalSecretKeyRef `json:"secretRef,omitempty"`
+	SecretRef *SecretKeyRef `json:"secretRef,omitempty"`
 
 	// privateKeyJwt client assertion settings. Required when method is PrivateKeyJwt.
 	// +optional
@@ -2282,7 +2483,7 @@ type MCPAuthentication struct {
 	// token requests it proxies to the provider. Defaults to the `clientSecret` key;
 	// override via `clientSecretRef.key`.
 	// +optional
-	ClientSecretRef *LocalSecretKeyRef `json:"clientSecretRef,omitempty"`
+	ClientSecretRef *SecretKeyRef `json:"clientSecretRef,omitempty"`
 }
 
 // +k8s:enum
@@ -2338,6 +2539,15 @@ type BackendTCP struct {
 	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('100ms')",message="connectTimeout must be at least 100ms"
 	// +optional
 	ConnectTimeout *metav1.Duration `json:"connectTimeout,omitempty"`
+
+	// Time a connection proxied by a `TCPRoute` may go without data in either
+	// direction before it is closed. HTTP connections to the destination are
+	// not affected. A value of `0s` disables the idle timeout, keeping idle
+	// connections open until either side closes them.
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	// +kubebuilder:validation:XValidation:rule="duration(self) == duration('0s') || duration(self) >= duration('1s')",message="idleTimeout must be 0s or at least 1 second"
+	// +optional
+	IdleTimeout *metav1.Duration `json:"idleTimeout,omitempty"`
 }
 
 // +kubebuilder:validation:AtLeastOneFieldSet
@@ -2890,6 +3100,7 @@ type RateLimitsConditional struct {
 
 // +kubebuilder:validation:ConditionalPolicy
 // +kubebuilder:validation:AtLeastOneFieldSet
+// +kubebuilder:validation:XValidation:message="tiered may not be combined with local or global",rule="!has(self.tiered) || !(has(self.local) || has(self.global))"
 type RateLimitsOrConditional struct {
 	// Local rate limiting policy.
 	// +kubebuilder:validation:MinItems=1
@@ -2910,6 +3121,46 @@ type RateLimitsOrConditional struct {
 	// +kubebuilder:validation:MaxItems=16
 	// +kubebuilder:validation:XValidation:message="conditional entries without condition must be last",rule="self.filter(e, !has(e.condition)).size() <= 1 && (!self.exists(e, !has(e.condition)) || !has(self[size(self) - 1].condition))"
 	Conditional []RateLimitsConditional `json:"conditional,omitempty"`
+
+	// Local rate limits selected by the value of a JWT claim.
+	// Requires `jwtAuthentication` to be set in the same policy.
+	// +optional
+	Tiered *RateLimitTiers `json:"tiered,omitempty"`
+}
+
+// RateLimitTiers selects a set of local rate limits based on the value of a JWT claim.
+// The first tier whose value matches the claim is applied; requests without the claim,
+// or whose claim matches no tier, use the default limits.
+type RateLimitTiers struct {
+	// Name of the JWT claim used to select a tier, for example `tier`.
+	// +required
+	Claim TinyString `json:"claim"`
+
+	// Rate limits to apply for each claim value.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +listType=map
+	// +listMapKey=value
+	// +required
+	Tiers []RateLimitTier `json:"tiers"`
+
+	// Rate limits to apply when the claim is missing or matches no tier.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +required
+	Default []LocalRateLimit `json:"default"`
+}
+
+type RateLimitTier struct {
+	// Claim value that selects this tier.
+	// +required
+	Value ShortString `json:"value"`
+
+	// Local rate limits to apply for this tier.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +required
+	Local []LocalRateLimit `json:"local"`
 }
 
 func (r *RateLimitsOrConditional) ConditionalPolicy() (*RateLimits, iter.Seq[ConditionalPolicyEntry[RateLimits]]) {
@@ -3058,6 +3309,21 @@ type CSRF struct {
 	AdditionalOrigins []ShortString `json:"additionalOrigins,omitempty"`
 }
 
+type HTTPSRedirect struct {
+	// HTTP status code used for the redirect.
+	// Defaults to `301`.
+	//
+	// +kubebuilder:default=301
+	// +kubebuilder:validation:Enum=301;302;303;307;308
+	// +optional
+	StatusCode *int32 `json:"statusCode,omitempty"`
+
+	// Port to redirect to.
+	// If unset, the default HTTPS port is used and omitted from the `Location` header.
+	// +optional
+	Port *gwv1.PortNumber `json:"port,omitempty"`
+}
+
 type HostnameRewrite struct {
 	// Hostname rewrite mode.
 	//
@@ -3127,12 +3393,34 @@ type AccessLog struct {
 	// +optional
 	Attributes *LogTracingAttributes `json:"attributes,omitempty"`
 
+	// Claims of the validated JWT to add to each entry, such as the subject
+	// and issuer. A claim is only logged when JWT authentication ran for the
+	// request and the token carried it; otherwise the pair is excluded.
+	// These are also added to OTLP entries that set their own `attributes`.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	JWTClaims []AccessLogJWTClaim `json:"jwtClaims,omitempty"`
+
 	// OTLP access log export to an
 	// OpenTelemetry-compatible backend.
 	// +optional
 	Otlp *OtlpAccessLog `json:"otlp,omitempty"`
 }
 
+type AccessLogJWTClaim struct {
+	// Name of the access log field, for example `auth.subject`.
+	// +required
+	Name ShortString `json:"name"`
+	// Claim to log. Nested claims are referenced with `.`, for example
+	// `realm_access.roles`.
+	// +kubebuilder:validation:Pattern=`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)*$`
+	// +required
+	Claim TinyString `json:"claim"`
+}
+
 // Ships access logs to an
 // OpenTelemetry-compatible backend via OTLP.
 // +kubebuilder:validation:XValidation:rule="!has(self.path) || !has(self.protocol) || self.protocol == 'HTTP'",message="path is only valid with protocol HTTP"
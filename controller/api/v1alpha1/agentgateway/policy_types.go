@@ -16,6 +16,12 @@ const (
 	// Possible reasons for this condition to be `False` are:
 	// * `Pending`
 	// * `Invalid`
+	// * `ReferenceNotFound`
+	// * `ReferenceNotPermitted`
+	// * `InvalidValue`
+	// * `InvalidCombination`
+	// * `Disabled`
+	// * `Inactive`
 	//
 	PolicyConditionAccepted PolicyConditionType = "Accepted"
 
@@ -28,30 +34,82 @@ const (
 	// Possible reasons for this condition to be `False` are:
 	// * `Pending`
 	// * `Overridden`
+	// * `TargetNotFound`
+	// * `Disabled`
+	// * `Inactive`
 	//
 	PolicyConditionAttached PolicyConditionType = "Attached"
 
+	// PolicyConditionProgrammed indicates whether the policy has been included
+	// in the configuration sent to the data plane for the ancestor. It is set
+	// once the configuration is published and does not wait for every proxy
+	// to acknowledge it.
+	//
+	// Possible reasons for this condition to be `True` are:
+	// * `Programmed`
+	//
+	// Possible reasons for this condition to be `False` are:
+	// * `Invalid`
+	// * `Pending`
+	// * `Disabled`
+	// * `Inactive`
+	//
+	PolicyConditionProgrammed PolicyConditionType = "Programmed"
+
 	// PolicyReasonValid is used with the `Accepted` condition when the policy
 	// has been accepted by the system.
 	PolicyReasonValid PolicyConditionReason = "Valid"
 
-	// PolicyReasonInvalid is used with the `Accepted` or `Attached` condition
-	// when the policy is syntactically or semantically invalid.
+	// PolicyReasonInvalid is used with the `Accepted`, `Attached`, or
+	// `Programmed` condition when the policy is syntactically or semantically
+	// invalid.
 	PolicyReasonInvalid PolicyConditionReason = "Invalid"
 
 	// PolicyReasonAttached is used with the `Attached` condition when the
 	// policy has been successfully attached to all the targeted resources.
 	PolicyReasonAttached PolicyConditionReason = "Attached"
 
-	// PolicyReasonPending is used with the `Accepted` or `Attached` condition
-	// when the policy has been referenced but not yet fully processed by the
-	// controller.
+	// PolicyReasonProgrammed is used with the `Programmed` condition when the
+	// policy has been sent to the data plane.
+	PolicyReasonProgrammed PolicyConditionReason = "Programmed"
+
+	// PolicyReasonPending is used with the `Accepted`, `Attached`, or
+	// `Programmed` condition when the policy has been referenced but not yet
+	// fully processed by the controller.
 	PolicyReasonPending PolicyConditionReason = "Pending"
 
 	// PolicyReasonPartiallyValid is used with the `Accepted` condition when the
 	// policy has been accepted by the system, but some of the referenced
 	// resources are not valid.
 	PolicyReasonPartiallyValid PolicyConditionReason = "PartiallyValid"
+
+	// PolicyReasonReferenceNotFound is used with the `Accepted` condition when
+	// the policy is invalid because a referenced resource does not exist.
+	PolicyReasonReferenceNotFound PolicyConditionReason = "ReferenceNotFound"
+
+	// PolicyReasonReferenceNotPermitted is used with the `Accepted` condition
+	// when the policy is invalid because a cross-namespace reference is not
+	// permitted by a ReferenceGrant.
+	PolicyReasonReferenceNotPermitted PolicyConditionReason = "ReferenceNotPermitted"
+
+	// PolicyReasonInvalidValue is used with the `Accepted` condition when the
+	// policy is invalid because a field has an unsupported value.
+	PolicyReasonInvalidValue PolicyConditionReason = "InvalidValue"
+
+	// PolicyReasonInvalidCombination is used with the `Accepted` condition
+	// when the policy is invalid because it combines fields that cannot be
+	// used together.
+	PolicyReasonInvalidCombination PolicyConditionReason = "InvalidCombination"
+
+	// PolicyReasonDisabled is used with the `Accepted`, `Attached`, and
+	// `Programmed` conditions when the policy has `enabled: false` and is
+	// therefore not applied to any target.
+	PolicyReasonDisabled PolicyConditionReason = "Disabled"
+
+	// PolicyReasonInactive is used with the `Accepted`, `Attached`, and
+	// `Programmed` conditions when the current time is outside the policy's
+	// `activeSchedule`, so it is not applied to any target.
+	PolicyReasonInactive PolicyConditionReason = "Inactive"
 )
 
 // PolicyDisable is used to disable a policy.
@@ -201,3 +201,99 @@ func (r LocalSecretKeyRef) ObjectRef() LocalSecretObjectRef {
 		Kind:  r.Kind,
 	}
 }
+
+// References a credential, in the same namespace unless `namespace` is set
+// Set only `name` for a Kubernetes Secret. A cross-namespace reference must be
+// permitted by a ReferenceGrant in the Secret's namespace.
+//
+// +structType=atomic
+// +kubebuilder:validation:XValidation:rule="(!has(self.group) || size(self.group) == 0) ? (!has(self.kind) || size(self.kind) == 0 || self.kind == 'Secret') : (has(self.kind) && size(self.kind) > 0)",message="custom credential refs must set both group and kind"
+// +kubebuilder:validation:XValidation:rule="!has(self.__namespace__) || ((!has(self.group) || size(self.group) == 0) && (!has(self.kind) || size(self.kind) == 0 || self.kind == 'Secret'))",message="namespace may only be set for Secret refs"
+type SecretObjectRef struct {
+	// Name of the referenced credential
+	// +required
+	Name gwv1.ObjectName `json:"name"`
+
+	// API group of the referenced credential; empty selects the core API group
+	// +optional
+	Group string `json:"group,omitempty"`
+
+	// Kind of the referenced credential; empty defaults to `Secret`
+	// +optional
+	Kind string `json:"kind,omitempty"`
+
+	// Namespace of the referenced Secret; empty selects the namespace of the
+	// referencing resource.
+	// +optional
+	Namespace *gwv1.Namespace `json:"namespace,omitempty"`
+}
+
+// References a credential, in the same namespace unless `namespace` is set,
+// and optional key
+// Set only `name` for a Kubernetes Secret. When `key` is omitted, a
+// location-specific default key is used. A cross-namespace reference must be
+// permitted by a ReferenceGrant in the Secret's namespace.
+//
+// +structType=atomic
+// +kubebuilder:validation:XValidation:rule="(!has(self.group) || size(self.group) == 0) ? (!has(self.kind) || size(self.kind) == 0 || self.kind == 'Secret') : (has(self.kind) && size(self.kind) > 0)",message="custom credential refs must set both group and kind"
+// +kubebuilder:validation:XValidation:rule="!has(self.__namespace__) || ((!has(self.group) || size(self.group) == 0) && (!has(self.kind) || size(self.kind) == 0 || self.kind == 'Secret'))",message="namespace may only be set for Secret refs"
+type SecretKeyRef struct {
+	// Name of the referenced credential
+	// +required
+	Name gwv1.ObjectName `json:"name"`
+
+	// API group of the referenced credential; empty selects the core API group
+	// +optional
+	Group string `json:"group,omitempty"`
+
+	// Kind of the referenced credential; empty defaults to `Secret`
+	// +optional
+	Kind string `json:"kind,omitempty"`
+
+	// Namespace of the referenced Secret; empty selects the namespace of the
+	// referencing resource.
+	// +optional
+	Namespace *gwv1.Namespace `json:"namespace,omitempty"`
+
+	// Key in the referenced Secret. If omitted, a location-specific default is used
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +optional
+	Key *string `json:"key,omitempty"`
+}
+
+// ObjectRef returns the credential reference without the key override.
+func (r SecretKeyRef) ObjectRef() SecretObjectRef {
+	return SecretObjectRef{
+		Name:      r.Name,
+		Group:     r.Group,
+		Kind:      r.Kind,
+		Namespace: r.Namespace,
+	}
+}
+
+// LocalRef returns the reference without its namespace, for credential
+// resolvers that take the namespace separately.
+func (r SecretObjectRef) LocalRef() LocalSecretObjectRef {
+	return LocalSecretObjectRef{
+		Name:  r.Name,
+		Group: r.Group,
+		Kind:  r.Kind,
+	}
+}
+
+// ResolvedNamespace returns the namespace of the referenced credential, using
+// defaultNamespace when the ref does not set one.
+func (r SecretObjectRef) ResolvedNamespace(defaultNamespace string) string {
+	if r.Namespace != nil && *r.Namespace != "" {
+		return string(*r.Namespace)
+	}
+	return defaultNamespace
+}
+
+// ResolvedNamespace returns the namespace of the referenced credential, using
+// defaultNamespace when the ref does not set one.
+func (r SecretKeyRef) ResolvedNamespace(defaultNamespace string) string {
+	return r.ObjectRef().ResolvedNamespace(defaultNamespace)
+}
@@ -5,10 +5,12 @@
 package agentgateway
 
 import (
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	apisv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
@@ -113,8 +115,8 @@ func (in *APIKeyAuthentication) DeepCopyInto(out *APIKeyAuthentication) {
 	*out = *in
 	if in.SecretRef != nil {
 		in, out := &in.SecretRef, &out.SecretRef
-		*out = new(LocalSecretObjectRef)
-		**out = **in
+		*out = new(SecretObjectRef)
+		(*in).DeepCopyInto(*out)
 	}
 	if in.SecretSelector != nil {
 		in, out := &in.SecretSelector, &out.SecretSelector
@@ -171,6 +173,13 @@ func (in *AccessLog) DeepCopyInto(out *AccessLog) {
 		*out = new(LogTracingAttributes)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.JWTClaims != nil {
+		in, out := &in.JWTClaims, &out.JWTClaims
+		*out = make([]AccessLogJWTClaim, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Otlp != nil {
 		in, out := &in.Otlp, &out.Otlp
 		*out = new(OtlpAccessLog)
@@ -188,6 +197,21 @@ func (in *AccessLog) DeepCopy() *AccessLog {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessLogJWTClaim) DeepCopyInto(out *AccessLogJWTClaim) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessLogJWTClaim.
+func (in *AccessLogJWTClaim) DeepCopy() *AccessLogJWTClaim {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessLogJWTClaim)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AgentExtAuthGRPC) DeepCopyInto(out *AgentExtAuthGRPC) {
 	*out = *in
@@ -429,6 +453,26 @@ func (in *AgentgatewayParameters) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentgatewayParametersAdmin) DeepCopyInto(out *AgentgatewayParametersAdmin) {
+	*out = *in
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentgatewayParametersAdmin.
+func (in *AgentgatewayParametersAdmin) DeepCopy() *AgentgatewayParametersAdmin {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentgatewayParametersAdmin)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AgentgatewayParametersConfigs) DeepCopyInto(out *AgentgatewayParametersConfigs) {
 	*out = *in
@@ -437,11 +481,45 @@ func (in *AgentgatewayParametersConfigs) DeepCopyInto(out *AgentgatewayParameter
 		*out = new(AgentgatewayParametersWorkload)
 		**out = **in
 	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Strategy != nil {
+		in, out := &in.Strategy, &out.Strategy
+		*out = new(appsv1.DeploymentStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Logging != nil {
 		in, out := &in.Logging, &out.Logging
 		*out = new(AgentgatewayParametersLogging)
 		**out = **in
 	}
+	if in.Admin != nil {
+		in, out := &in.Admin, &out.Admin
+		*out = new(AgentgatewayParametersAdmin)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tracing != nil {
+		in, out := &in.Tracing, &out.Tracing
+		*out = new(AgentgatewayParametersTracing)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.RawConfig != nil {
 		in, out := &in.RawConfig, &out.RawConfig
 		*out = new(apiextensionsv1.JSON)
@@ -464,11 +542,74 @@ func (in *AgentgatewayParametersConfigs) DeepCopyInto(out *AgentgatewayParameter
 		*out = new(corev1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PodSecurityContext != nil {
+		in, out := &in.PodSecurityContext, &out.PodSecurityContext
+		*out = new(corev1.PodSecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecurityContext != nil {
+		in, out := &in.SecurityContext, &out.SecurityContext
+		*out = new(corev1.SecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InitContainers != nil {
+		in, out := &in.InitContainers, &out.InitContainers
+		*out = make([]corev1.Container, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Sidecars != nil {
+		in, out := &in.Sidecars, &out.Sidecars
+		*out = make([]corev1.Container, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Shutdown != nil {
 		in, out := &in.Shutdown, &out.Shutdown
 		*out = new(ShutdownSpec)
 		**out = **in
 	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PriorityClassName != nil {
+		in, out := &in.PriorityClassName, &out.PriorityClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServiceAccountName != nil {
+		in, out := &in.ServiceAccountName, &out.ServiceAccountName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ImagePullSecrets != nil {
+		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
+		*out = make([]corev1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.ServiceConfig != nil {
+		in, out := &in.ServiceConfig, &out.ServiceConfig
+		*out = new(AgentgatewayParametersServiceConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodDisruptionBudgetConfig != nil {
+		in, out := &in.PodDisruptionBudgetConfig, &out.PodDisruptionBudgetConfig
+		*out = new(AgentgatewayParametersPodDisruptionBudgetConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Istio != nil {
 		in, out := &in.Istio, &out.Istio
 		*out = new(IstioSpec)
@@ -583,6 +724,78 @@ func (in *AgentgatewayParametersOverlays) DeepCopy() *AgentgatewayParametersOver
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentgatewayParametersPodDisruptionBudgetConfig) DeepCopyInto(out *AgentgatewayParametersPodDisruptionBudgetConfig) {
+	*out = *in
+	if in.MinAvailable != nil {
+		in, out := &in.MinAvailable, &out.MinAvailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentgatewayParametersPodDisruptionBudgetConfig.
+func (in *AgentgatewayParametersPodDisruptionBudgetConfig) DeepCopy() *AgentgatewayParametersPodDisruptionBudgetConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentgatewayParametersPodDisruptionBudgetConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentgatewayParametersSecretKeySelector) DeepCopyInto(out *AgentgatewayParametersSecretKeySelector) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentgatewayParametersSecretKeySelector.
+func (in *AgentgatewayParametersSecretKeySelector) DeepCopy() *AgentgatewayParametersSecretKeySelector {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentgatewayParametersSecretKeySelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentgatewayParametersServiceConfig) DeepCopyInto(out *AgentgatewayParametersServiceConfig) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(corev1.ServiceType)
+		**out = **in
+	}
+	if in.LoadBalancerClass != nil {
+		in, out := &in.LoadBalancerClass, &out.LoadBalancerClass
+		*out = new(string)
+		**out = **in
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentgatewayParametersServiceConfig.
+func (in *AgentgatewayParametersServiceConfig) DeepCopy() *AgentgatewayParametersServiceConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentgatewayParametersServiceConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AgentgatewayParametersSpec) DeepCopyInto(out *AgentgatewayParametersSpec) {
 	*out = *in
@@ -615,6 +828,42 @@ func (in *AgentgatewayParametersStatus) DeepCopy() *AgentgatewayParametersStatus
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentgatewayParametersTracing) DeepCopyInto(out *AgentgatewayParametersTracing) {
+	*out = *in
+	if in.SecretHeaders != nil {
+		in, out := &in.SecretHeaders, &out.SecretHeaders
+		*out = make([]AgentgatewayParametersTracingSecretHeader, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentgatewayParametersTracing.
+func (in *AgentgatewayParametersTracing) DeepCopy() *AgentgatewayParametersTracing {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentgatewayParametersTracing)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentgatewayParametersTracingSecretHeader) DeepCopyInto(out *AgentgatewayParametersTracingSecretHeader) {
+	*out = *in
+	out.SecretKeyRef = in.SecretKeyRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentgatewayParametersTracingSecretHeader.
+func (in *AgentgatewayParametersTracingSecretHeader) DeepCopy() *AgentgatewayParametersTracingSecretHeader {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentgatewayParametersTracingSecretHeader)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AgentgatewayParametersWorkload) DeepCopyInto(out *AgentgatewayParametersWorkload) {
 	*out = *in
@@ -706,6 +955,16 @@ func (in *AgentgatewayPolicySpec) DeepCopyInto(out *AgentgatewayPolicySpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ActiveSchedule != nil {
+		in, out := &in.ActiveSchedule, &out.ActiveSchedule
+		*out = new(PolicySchedule)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Strategy != nil {
 		in, out := &in.Strategy, &out.Strategy
 		*out = new(PolicyStrategy)
@@ -1556,6 +1815,11 @@ func (in *BackendTCP) DeepCopyInto(out *BackendTCP) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.IdleTimeout != nil {
+		in, out := &in.IdleTimeout, &out.IdleTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendTCP.
@@ -2777,6 +3041,31 @@ func (in *GoogleModelArmorPolicy) DeepCopy() *GoogleModelArmorPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPSRedirect) DeepCopyInto(out *HTTPSRedirect) {
+	*out = *in
+	if in.StatusCode != nil {
+		in, out := &in.StatusCode, &out.StatusCode
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(apisv1.PortNumber)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPSRedirect.
+func (in *HTTPSRedirect) DeepCopy() *HTTPSRedirect {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPSRedirect)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HeaderModifiers) DeepCopyInto(out *HeaderModifiers) {
 	*out = *in
@@ -2935,6 +3224,11 @@ func (in *JWKS) DeepCopyInto(out *JWKS) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Format != nil {
+		in, out := &in.Format, &out.Format
+		*out = new(JWKSFormat)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWKS.
@@ -2962,6 +3256,13 @@ func (in *JWTAuthentication) DeepCopyInto(out *JWTAuthentication) {
 		*out = new(AuthorizationExtractionLocation)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.TokenSources != nil {
+		in, out := &in.TokenSources, &out.TokenSources
+		*out = make([]AuthorizationLocation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.MCP != nil {
 		in, out := &in.MCP, &out.MCP
 		*out = new(JWTMCPConfig)
@@ -3019,6 +3320,13 @@ func (in *JWTProvider) DeepCopyInto(out *JWTProvider) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.RequiredClaims != nil {
+		in, out := &in.RequiredClaims, &out.RequiredClaims
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	in.JWKS.DeepCopyInto(&out.JWKS)
 }
 
@@ -3381,7 +3689,7 @@ func (in *MCPAuthentication) DeepCopyInto(out *MCPAuthentication) {
 	}
 	if in.ClientSecretRef != nil {
 		in, out := &in.ClientSecretRef, &out.ClientSecretRef
-		*out = new(LocalSecretKeyRef)
+		*out = new(SecretKeyRef)
 		(*in).DeepCopyInto(*out)
 	}
 }
@@ -3605,6 +3913,47 @@ func (in *Message) DeepCopy() *Message {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetadataHeader) DeepCopyInto(out *MetadataHeader) {
+	*out = *in
+	in.ValueFrom.DeepCopyInto(&out.ValueFrom)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetadataHeader.
+func (in *MetadataHeader) DeepCopy() *MetadataHeader {
+	if in == nil {
+		return nil
+	}
+	out := new(MetadataHeader)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetadataHeaderSource) DeepCopyInto(out *MetadataHeaderSource) {
+	*out = *in
+	if in.Claim != nil {
+		in, out := &in.Claim, &out.Claim
+		*out = new(string)
+		**out = **in
+	}
+	if in.RouteAttribute != nil {
+		in, out := &in.RouteAttribute, &out.RouteAttribute
+		*out = new(RouteAttribute)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetadataHeaderSource.
+func (in *MetadataHeaderSource) DeepCopy() *MetadataHeaderSource {
+	if in == nil {
+		return nil
+	}
+	out := new(MetadataHeaderSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MetricAttributes) DeepCopyInto(out *MetricAttributes) {
 	*out = *in
@@ -3793,7 +4142,7 @@ func (in *OAuthClientAuth) DeepCopyInto(out *OAuthClientAuth) {
 	*out = *in
 	if in.SecretRef != nil {
 		in, out := &in.SecretRef, &out.SecretRef
-		*out = new(LocalSecretKeyRef)
+		*out = new(SecretKeyRef)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.PrivateKeyJWT != nil {
@@ -4186,6 +4535,29 @@ func (in *PolicyDisable) DeepCopy() *PolicyDisable {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicySchedule) DeepCopyInto(out *PolicySchedule) {
+	*out = *in
+	if in.Start != nil {
+		in, out := &in.Start, &out.Start
+		*out = (*in).DeepCopy()
+	}
+	if in.End != nil {
+		in, out := &in.End, &out.End
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicySchedule.
+func (in *PolicySchedule) DeepCopy() *PolicySchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicySchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PolicyStatus) DeepCopyInto(out *PolicyStatus) {
 	*out = *in
@@ -4464,6 +4836,57 @@ func (in *RateLimitDescriptorEntry) DeepCopy() *RateLimitDescriptorEntry {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitTier) DeepCopyInto(out *RateLimitTier) {
+	*out = *in
+	if in.Local != nil {
+		in, out := &in.Local, &out.Local
+		*out = make([]LocalRateLimit, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitTier.
+func (in *RateLimitTier) DeepCopy() *RateLimitTier {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitTier)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitTiers) DeepCopyInto(out *RateLimitTiers) {
+	*out = *in
+	if in.Tiers != nil {
+		in, out := &in.Tiers, &out.Tiers
+		*out = make([]RateLimitTier, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = make([]LocalRateLimit, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitTiers.
+func (in *RateLimitTiers) DeepCopy() *RateLimitTiers {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitTiers)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RateLimits) DeepCopyInto(out *RateLimits) {
 	*out = *in
@@ -4529,6 +4952,11 @@ func (in *RateLimitsOrConditional) DeepCopyInto(out *RateLimitsOrConditional) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Tiered != nil {
+		in, out := &in.Tiered, &out.Tiered
+		*out = new(RateLimitTiers)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitsOrConditional.
@@ -4637,6 +5065,51 @@ func (in *Retry) DeepCopy() *Retry {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretKeyRef) DeepCopyInto(out *SecretKeyRef) {
+	*out = *in
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(apisv1.Namespace)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretKeyRef.
+func (in *SecretKeyRef) DeepCopy() *SecretKeyRef {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretKeyRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretObjectRef) DeepCopyInto(out *SecretObjectRef) {
+	*out = *in
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(apisv1.Namespace)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretObjectRef.
+func (in *SecretObjectRef) DeepCopy() *SecretObjectRef {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretObjectRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretSelector) DeepCopyInto(out *SecretSelector) {
 	*out = *in
@@ -4810,6 +5283,11 @@ func (in *Traffic) DeepCopyInto(out *Traffic) {
 		*out = new(HostnameRewrite)
 		**out = **in
 	}
+	if in.HTTPSRedirect != nil {
+		in, out := &in.HTTPSRedirect, &out.HTTPSRedirect
+		*out = new(HTTPSRedirect)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Timeouts != nil {
 		in, out := &in.Timeouts, &out.Timeouts
 		*out = new(Timeouts)
@@ -4825,6 +5303,11 @@ func (in *Traffic) DeepCopyInto(out *Traffic) {
 		*out = new(Authorization)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DefaultDeny != nil {
+		in, out := &in.DefaultDeny, &out.DefaultDeny
+		*out = new(bool)
+		**out = **in
+	}
 	if in.JWTAuthentication != nil {
 		in, out := &in.JWTAuthentication, &out.JWTAuthentication
 		*out = new(JWTAuthentication)
@@ -4850,11 +5333,28 @@ func (in *Traffic) DeepCopyInto(out *Traffic) {
 		*out = new(Buffer)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MaxRequestBytes != nil {
+		in, out := &in.MaxRequestBytes, &out.MaxRequestBytes
+		x := (*in).DeepCopy()
+		*out = &x
+	}
 	if in.Delay != nil {
 		in, out := &in.Delay, &out.Delay
 		*out = new(Delay)
 		**out = **in
 	}
+	if in.MetadataHeaders != nil {
+		in, out := &in.MetadataHeaders, &out.MetadataHeaders
+		*out = make([]MetadataHeader, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TCP != nil {
+		in, out := &in.TCP, &out.TCP
+		*out = new(TrafficTCP)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Traffic.
@@ -4867,6 +5367,26 @@ func (in *Traffic) DeepCopy() *Traffic {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficTCP) DeepCopyInto(out *TrafficTCP) {
+	*out = *in
+	if in.IdleTimeout != nil {
+		in, out := &in.IdleTimeout, &out.IdleTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficTCP.
+func (in *TrafficTCP) DeepCopy() *TrafficTCP {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficTCP)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Transform) DeepCopyInto(out *Transform) {
 	*out = *in
@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/go-jose/go-jose/v4"
+	"golang.org/x/net/http/httpproxy"
 	"istio.io/istio/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
@@ -143,10 +144,14 @@ func makeFetchClient(tlsConfig *tls.Config, proxyURL string, proxyTLSConfig *tls
 		DialContext:       dialer.DialContext,
 		DisableKeepAlives: true,
 	}
-	if proxyURL != "" {
-		parsed, err := url.Parse(proxyURL)
+	if proxyURL == "" {
+		// Without an explicit tunnel, fall back to the controller's egress proxy environment
+		// (HTTP_PROXY, HTTPS_PROXY, NO_PROXY) so fetches work without direct internet access.
+		transport.Proxy = environmentProxy()
+	} else {
+		parsed, err := parseProxyURL(proxyURL)
 		if err != nil {
-			return nil, fmt.Errorf("error parsing proxy URL %q: %w", proxyURL, err)
+			return nil, err
 		}
 		if proxyTLSConfig != nil {
 			// Downgrade the proxy URL scheme to http so that Go's transport
@@ -166,6 +171,32 @@ func makeFetchClient(tlsConfig *tls.Config, proxyURL string, proxyTLSConfig *tls
 	}, nil
 }
 
+// parseProxyURL parses and validates an explicit proxy URL. Only http and https
+// proxies with a host are supported.
+func parseProxyURL(proxyURL string) (*url.URL, error) {
+	parsed, err := url.Parse(proxyURL)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing proxy URL %q: %w", proxyURL, err)
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return nil, fmt.Errorf("invalid proxy URL %q: scheme must be http or https", proxyURL)
+	}
+	if parsed.Host == "" {
+		return nil, fmt.Errorf("invalid proxy URL %q: missing host", proxyURL)
+	}
+	return parsed, nil
+}
+
+// environmentProxy returns a proxy function built from the current HTTP_PROXY,
+// HTTPS_PROXY, and NO_PROXY environment variables. Unlike http.ProxyFromEnvironment,
+// the environment is read on every call rather than once per process.
+func environmentProxy() func(*http.Request) (*url.URL, error) {
+	proxyFunc := httpproxy.FromEnvironment().ProxyFunc()
+	return func(req *http.Request) (*url.URL, error) {
+		return proxyFunc(req.URL)
+	}
+}
+
 // proxyTLSDialContext returns a DialContext function that wraps TCP connections
 // in TLS using the given proxy TLS configuration. This is used when the tunnel
 // proxy backend has a TLS policy, so the CONNECT request is sent over TLS.
@@ -226,7 +257,7 @@ type jwksHttpClientImpl struct {
 }
 
 func NewFetcher(cache *JwksCache) *Fetcher {
-	// Default client has no TLS or proxy config, so makeFetchClient cannot fail.
+	// Default client has no TLS or explicit proxy config, so makeFetchClient cannot fail.
 	defaultClient, _ := makeFetchClient(nil, "", nil)
 	return &Fetcher{
 		cache:             cache,
@@ -455,6 +455,46 @@ func TestMakeFetchClientRejectsInvalidProxyURL(t *testing.T) {
 	assert.Contains(t, err.Error(), "error parsing proxy URL")
 }
 
+func TestMakeFetchClientRejectsUnsupportedProxyURL(t *testing.T) {
+	_, err := makeFetchClient(nil, "socks5://proxy:1080", nil)
+	assert.ErrorContains(t, err, "scheme must be http or https")
+
+	_, err = makeFetchClient(nil, "http://", nil)
+	assert.ErrorContains(t, err, "missing host")
+}
+
+func TestMakeFetchClientUsesEnvironmentProxy(t *testing.T) {
+	t.Setenv("HTTPS_PROXY", "http://egress.internal:3128")
+	t.Setenv("NO_PROXY", "idp.internal")
+
+	client, err := makeFetchClient(nil, "", nil)
+	require.NoError(t, err)
+	transport := client.Transport.(*http.Transport)
+	require.NotNil(t, transport.Proxy)
+
+	proxied, err := transport.Proxy(httptest.NewRequest(http.MethodGet, "https://issuer.example.com/jwks", nil))
+	require.NoError(t, err)
+	require.NotNil(t, proxied)
+	assert.Equal(t, "egress.internal:3128", proxied.Host)
+
+	bypassed, err := transport.Proxy(httptest.NewRequest(http.MethodGet, "https://idp.internal/jwks", nil))
+	require.NoError(t, err)
+	assert.Nil(t, bypassed, "NO_PROXY hosts should be fetched directly")
+}
+
+func TestMakeFetchClientExplicitProxyOverridesEnvironment(t *testing.T) {
+	t.Setenv("HTTPS_PROXY", "http://egress.internal:3128")
+
+	client, err := makeFetchClient(nil, "http://tunnel.internal:8080", nil)
+	require.NoError(t, err)
+	transport := client.Transport.(*http.Transport)
+
+	proxied, err := transport.Proxy(httptest.NewRequest(http.MethodGet, "https://issuer.example.com/jwks", nil))
+	require.NoError(t, err)
+	require.NotNil(t, proxied)
+	assert.Equal(t, "tunnel.internal:8080", proxied.Host)
+}
+
 func TestProxyURLAffectsFetchKey(t *testing.T) {
 	a := remotehttp.FetchTarget{URL: "https://example.com/jwks"}
 	b := remotehttp.FetchTarget{URL: "https://example.com/jwks", ProxyURL: "http://proxy:8080"}
@@ -1,6 +1,7 @@
 package plugins
 
 import (
+	"errors"
 	"fmt"
 	"log/slog"
 
@@ -13,12 +14,15 @@ import (
 
 func processRequestGuard(ctx PolicyCtx, namespace string, reqs []agentgateway.PromptguardRequest) ([]*api.BackendPolicySpec_Ai_RequestGuard, error) {
 	var res []*api.BackendPolicySpec_Ai_RequestGuard
+	var errs []error
 	for _, req := range reqs {
 		pgReq := &api.BackendPolicySpec_Ai_RequestGuard{}
 		if req.Webhook != nil {
+			// Keep the guard even if the backend does not resolve, so calls to it
+			// fail and its failure mode decides whether the request proceeds.
 			wh, err := processWebhook(ctx, namespace, req.Webhook)
 			if err != nil {
-				return nil, err
+				errs = append(errs, err)
 			}
 			pgReq.Kind = &api.BackendPolicySpec_Ai_RequestGuard_Webhook{
 				Webhook: wh,
@@ -50,17 +54,20 @@ func processRequestGuard(ctx PolicyCtx, namespace string, reqs []agentgateway.Pr
 		res = append(res, pgReq)
 	}
 
-	return res, nil
+	return res, errors.Join(errs...)
 }
 
 func processResponseGuard(ctx PolicyCtx, namespace string, resps []agentgateway.PromptguardResponse) ([]*api.BackendPolicySpec_Ai_ResponseGuard, error) {
 	var res []*api.BackendPolicySpec_Ai_ResponseGuard
+	var errs []error
 	for _, req := range resps {
 		pgReq := &api.BackendPolicySpec_Ai_ResponseGuard{}
 		if req.Webhook != nil {
+			// Keep the guard even if the backend does not resolve, so calls to it
+			// fail and its failure mode decides whether the request proceeds.
 			wh, err := processWebhook(ctx, namespace, req.Webhook)
 			if err != nil {
-				return nil, err
+				errs = append(errs, err)
 			}
 			pgReq.Kind = &api.BackendPolicySpec_Ai_ResponseGuard_Webhook{
 				Webhook: wh,
@@ -88,7 +95,7 @@ func processResponseGuard(ctx PolicyCtx, namespace string, resps []agentgateway.
 		res = append(res, pgReq)
 	}
 
-	return res, nil
+	return res, errors.Join(errs...)
 }
 
 func processPromptEnrichment(enrichment *agentgateway.AIPromptEnrichment) *api.BackendPolicySpec_Ai_PromptEnrichment {
@@ -118,9 +125,10 @@ func processWebhook(ctx PolicyCtx, namespace string, webhook *agentgateway.Webho
 		return nil, nil
 	}
 
+	var errs []error
 	be, err := BuildBackendRef(ctx, webhook.BackendRef, namespace)
 	if err != nil {
-		return nil, fmt.Errorf("failed to build webhook: %v", err)
+		errs = append(errs, fmt.Errorf("failed to build webhook: %w", err))
 	}
 
 	w := &api.BackendPolicySpec_Ai_Webhook{
@@ -148,7 +156,7 @@ func processWebhook(ctx PolicyCtx, namespace string, webhook *agentgateway.Webho
 		w.ForwardHeaderMatches = headers
 	}
 
-	return w, nil
+	return w, errors.Join(errs...)
 }
 
 func webhookFailureMode(mode agentgateway.FailureMode) api.BackendPolicySpec_Ai_Webhook_FailureMode {
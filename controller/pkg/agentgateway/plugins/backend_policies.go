@@ -33,6 +33,7 @@ const (
 	backendTransformationSuffix   = ":backend-transformation"
 	tlsPolicySuffix               = ":tls"
 	backendHttpPolicySuffix       = ":backend-http"
+	backendTcpPolicySuffix        = ":backend-tcp"
 	mcpAuthorizationPolicySuffix  = ":mcp-authorization"
 	mcpAuthenticationPolicySuffix = ":mcp-authentication"
 	mcpGuardrailsPolicySuffix     = ":mcp-guardrails"
@@ -109,11 +110,38 @@ func translateAuthorizationExtractionLocation(loc *agentgateway.AuthorizationExt
 	return nil
 }
 
+// validateAuthorizationLocation checks that exactly one of header, queryParameter, or cookie is set with a non-empty name.
+func validateAuthorizationLocation(loc *agentgateway.AuthorizationLocation, context string) error {
+	set := 0
+	if loc.Header != nil {
+		set++
+		if loc.Header.Name == "" {
+			return categorizedErrorf(PolicyErrorCategoryInvalidValue, "%s header name must not be empty", context)
+		}
+	}
+	if loc.QueryParameter != nil {
+		set++
+		if loc.QueryParameter.Name == "" {
+			return categorizedErrorf(PolicyErrorCategoryInvalidValue, "%s queryParameter name must not be empty", context)
+		}
+	}
+	if loc.Cookie != nil {
+		set++
+		if loc.Cookie.Name == "" {
+			return categorizedErrorf(PolicyErrorCategoryInvalidValue, "%s cookie name must not be empty", context)
+		}
+	}
+	if set != 1 {
+		return categorizedErrorf(PolicyErrorCategoryInvalidCombination, "%s must set exactly one of header, queryParameter, or cookie", context)
+	}
+	return nil
+}
+
 func validateExtractionAuthorizationLocation(loc *agentgateway.AuthorizationExtractionLocation, context string) error {
 	if loc == nil || loc.Expression == nil || isCEL(*loc.Expression) {
 		return nil
 	}
-	return fmt.Errorf("%s expression is not a valid CEL expression: %s", context, *loc.Expression)
+	return categorizedErrorf(PolicyErrorCategoryInvalidValue, "%s expression is not a valid CEL expression: %s", context, *loc.Expression)
 }
 
 func TranslateInlineBackendPolicy(
@@ -172,7 +200,7 @@ func translateBackendPolicyToAgw(
 	}
 
 	if s := backend.TCP; s != nil {
-		appendPolicy("backendTCP")(translateBackendTCP(ctx, policy, policyName))
+		appendPolicy("backendTCP")(translateBackendTCP(policy))
 	}
 
 	if s := backend.Health; s != nil {
@@ -240,10 +268,10 @@ func translateBackendMCPGuardrails(ctx PolicyCtx, policy *agentgateway.Agentgate
 		}
 		be, err := BuildBackendRef(ctx, p.Remote.BackendRef, policy.Namespace)
 		if err != nil {
-			errs = append(errs, fmt.Errorf("failed to build mcpGuardrails: %v", err))
+			errs = append(errs, fmt.Errorf("failed to build mcpGuardrails: %w", err))
 		}
 		metadata := castCELMap(p.Remote.Metadata, func(key string, expr agentgateway.CELExpression) {
-			errs = append(errs, fmt.Errorf("mcpGuardrails metadata %q is not a valid CEL expression: %s", key, expr))
+			errs = append(errs, categorizedErrorf(PolicyErrorCategoryInvalidValue, "mcpGuardrails metadata %q is not a valid CEL expression: %s", key, expr))
 		})
 		methods := make(map[string]api.BackendPolicySpec_McpGuardrails_Phase, len(p.Methods))
 		for name, phase := range p.Methods {
@@ -332,7 +360,7 @@ func translateBackendHealthPolicy(policy *agentgateway.AgentgatewayPolicy) (*api
 	var unhealthyCondition string
 	if healthPolicy.UnhealthyCondition != nil {
 		unhealthyCondition = *castCELPtr(healthPolicy.UnhealthyCondition, func(expr agentgateway.CELExpression) {
-			errs = append(errs, fmt.Errorf("backend health unhealthyCondition is not a valid CEL expression: %s", expr))
+			errs = append(errs, categorizedErrorf(PolicyErrorCategoryInvalidValue, "backend health unhealthyCondition is not a valid CEL expression: %s", expr))
 		})
 	}
 
@@ -341,7 +369,7 @@ func translateBackendHealthPolicy(policy *agentgateway.AgentgatewayPolicy) (*api
 		Eviction:           evictionProto,
 	}
 	evictPolicy := &api.Policy{
-		Key:  policy.Namespace + "/" + policy.Name + healthPolicySuffix,
+		Key:  policyKey(policy.Namespace, policy.Name) + healthPolicySuffix,
 		Name: TypedResourceName(wellknown.AgentgatewayPolicyGVK.Kind, policy),
 		Kind: &api.Policy_Backend{
 			Backend: &api.BackendPolicySpec{
@@ -355,9 +383,50 @@ func translateBackendHealthPolicy(policy *agentgateway.AgentgatewayPolicy) (*api
 	return evictPolicy, errors.Join(errs...)
 }
 
-func translateBackendTCP(ctx PolicyCtx, policy *agentgateway.AgentgatewayPolicy, name string) (*api.Policy, error) {
-	// TODO
-	return nil, nil
+func translateBackendTCP(policy *agentgateway.AgentgatewayPolicy) (*api.Policy, error) {
+	tcp := policy.Spec.Backend.TCP
+	p := &api.BackendPolicySpec_BackendTCP{}
+	if ka := tcp.Keepalive; ka != nil {
+		p.Keepalive = &api.KeepaliveConfig{}
+		if ka.Time != nil {
+			p.Keepalive.Time = durationpb.New(ka.Time.Duration)
+		}
+		if ka.Interval != nil {
+			p.Keepalive.Interval = durationpb.New(ka.Interval.Duration)
+		}
+		if ka.Retries != nil {
+			p.Keepalive.Retries = castUint32(ka.Retries) //nolint:gosec // G115: kubebuilder validation ensures safe for uint32
+		}
+	}
+	if ct := tcp.ConnectTimeout; ct != nil {
+		if ct.Duration <= 0 {
+			return nil, categorizedErrorf(PolicyErrorCategoryInvalidValue, "tcp connectTimeout must be positive, got %v", ct.Duration)
+		}
+		p.ConnectTimeout = durationpb.New(ct.Duration)
+	}
+	if it := tcp.IdleTimeout; it != nil {
+		// Zero is passed through as-is; it disables the idle timeout.
+		if it.Duration < 0 {
+			return nil, categorizedErrorf(PolicyErrorCategoryInvalidValue, "tcp idleTimeout must not be negative, got %v", it.Duration)
+		}
+		p.IdleTimeout = durationpb.New(it.Duration)
+	}
+	tp := &api.Policy{
+		Key:  policyKey(policy.Namespace, policy.Name) + backendTcpPolicySuffix,
+		Name: TypedResourceName(wellknown.AgentgatewayPolicyGVK.Kind, policy),
+		Kind: &api.Policy_Backend{
+			Backend: &api.BackendPolicySpec{
+				Kind: &api.BackendPolicySpec_BackendTcp{
+					BackendTcp: p,
+				},
+			},
+		},
+	}
+	logger.Debug("generated TCP policy",
+		"policy", policy.Name,
+		"agentgateway_policy", tp.Name)
+
+	return tp, nil
 }
 
 func translateBackendTransformation(
@@ -376,7 +445,7 @@ func translateBackendTransformation(
 	}
 
 	tp := &api.Policy{
-		Key:  policy.Namespace + "/" + policy.Name + backendTransformationSuffix,
+		Key:  policyKey(policy.Namespace, policy.Name) + backendTransformationSuffix,
 		Name: TypedResourceName(wellknown.AgentgatewayPolicyGVK.Kind, policy),
 		Kind: &api.Policy_Backend{
 			Backend: &api.BackendPolicySpec{
@@ -469,7 +538,7 @@ func translateBackendTLS(ctx PolicyCtx, policy *agentgateway.AgentgatewayPolicy)
 	p.KeyExchangeGroups = convertTLSKeyExchangeGroups(tls.KeyExchangeGroups)
 
 	tlsPolicy := &api.Policy{
-		Key:  policy.Namespace + "/" + policy.Name + tlsPolicySuffix,
+		Key:  policyKey(policy.Namespace, policy.Name) + tlsPolicySuffix,
 		Name: TypedResourceName(wellknown.AgentgatewayPolicyGVK.Kind, policy),
 		Kind: &api.Policy_Backend{
 			Backend: &api.BackendPolicySpec{
@@ -502,7 +571,7 @@ func translateBackendHTTP(policy *agentgateway.AgentgatewayPolicy) *api.Policy {
 		p.RequestTimeout = durationpb.New(rt.Duration)
 	}
 	tp := &api.Policy{
-		Key:  policy.Namespace + "/" + policy.Name + backendHttpPolicySuffix,
+		Key:  policyKey(policy.Namespace, policy.Name) + backendHttpPolicySuffix,
 		Name: TypedResourceName(wellknown.AgentgatewayPolicyGVK.Kind, policy),
 		Kind: &api.Policy_Backend{
 			Backend: &api.BackendPolicySpec{
@@ -525,7 +594,7 @@ func translateBackendTunnel(ctx PolicyCtx, policy *agentgateway.AgentgatewayPoli
 	proxy, err := BuildBackendRef(ctx, tunnel.BackendRef, policy.Namespace)
 
 	tunnelPolicy := &api.Policy{
-		Key:  policy.Namespace + "/" + policy.Name + backendTunnelPolicySuffix,
+		Key:  policyKey(policy.Namespace, policy.Name) + backendTunnelPolicySuffix,
 		Name: TypedResourceName(wellknown.AgentgatewayPolicyGVK.Kind, policy),
 		Kind: &api.Policy_Backend{
 			Backend: &api.BackendPolicySpec{
@@ -554,7 +623,7 @@ func translateBackendMCPAuthorization(policy *agentgateway.AgentgatewayPolicy) (
 	var errs []error
 	var allowPolicies, denyPolicies, requirePolicies []string
 	policies := castCELSlice(auth.Policy.MatchExpressions, func(expr agentgateway.CELExpression) {
-		errs = append(errs, fmt.Errorf("backend MCP authorization matchExpression is not a valid CEL expression: %s", expr))
+		errs = append(errs, categorizedErrorf(PolicyErrorCategoryInvalidValue, "backend MCP authorization matchExpression is not a valid CEL expression: %s", expr))
 	})
 	if auth.Action == agentgateway.AuthorizationPolicyActionDeny {
 		denyPolicies = append(denyPolicies, policies...)
@@ -565,7 +634,7 @@ func translateBackendMCPAuthorization(policy *agentgateway.AgentgatewayPolicy) (
 	}
 
 	mcpPolicy := &api.Policy{
-		Key:  policy.Namespace + "/" + policy.Name + mcpAuthorizationPolicySuffix,
+		Key:  policyKey(policy.Namespace, policy.Name) + mcpAuthorizationPolicySuffix,
 		Name: TypedResourceName(wellknown.AgentgatewayPolicyGVK.Kind, policy),
 		Kind: &api.Policy_Backend{
 			Backend: &api.BackendPolicySpec{
@@ -595,7 +664,7 @@ func translateBackendMCPAuthentication(ctx PolicyCtx, policy *agentgateway.Agent
 		Name:      policy.Name,
 	}, authnPolicy)
 	mcpAuthnPolicy := &api.Policy{
-		Key:  policy.Namespace + "/" + policy.Name + mcpAuthenticationPolicySuffix,
+		Key:  policyKey(policy.Namespace, policy.Name) + mcpAuthenticationPolicySuffix,
 		Name: TypedResourceName(wellknown.AgentgatewayPolicyGVK.Kind, policy),
 		Kind: &api.Policy_Backend{
 			Backend: &api.BackendPolicySpec{
@@ -658,11 +727,11 @@ func translateMCPAuthenticationSpec(
 	}
 
 	if authnPolicy.ClientSecretRef != nil {
-		data, key, err := ctx.ResolveCredentialKeyRef(*authnPolicy.ClientSecretRef, policy.Namespace, wellknown.ClientSecret)
+		data, key, err := ctx.ResolveSecretKeyRef(*authnPolicy.ClientSecretRef, policy.Namespace, wellknown.ClientSecret)
 		if err != nil {
 			errs = append(errs, err)
 		} else if value, exists := kubeutils.GetSecretDataValue(data, key); !exists || value == "" {
-			errs = append(errs, fmt.Errorf("secret %s/%s missing %s value", policy.Namespace, authnPolicy.ClientSecretRef.Name, key))
+			errs = append(errs, fmt.Errorf("secret %s/%s missing %s value", authnPolicy.ClientSecretRef.ResolvedNamespace(policy.Namespace), authnPolicy.ClientSecretRef.Name, key))
 		} else {
 			mcpAuthn.ClientSecret = &value
 		}
@@ -773,7 +842,7 @@ func translateBackendAI(ctx PolicyCtx, agwPolicy *agentgateway.AgentgatewayPolic
 		}
 
 		if !isCEL(xfm.Expression) {
-			errs = append(errs, fmt.Errorf("transformation %q is not a valid CEL expression: %v", xfm.Field, xfm.Expression))
+			errs = append(errs, categorizedErrorf(PolicyErrorCategoryInvalidValue, "transformation %q is not a valid CEL expression: %v", xfm.Field, xfm.Expression))
 		}
 
 		// Still set it so it wipes out the value on error, mirroring the header value.
@@ -792,9 +861,8 @@ func translateBackendAI(ctx PolicyCtx, agwPolicy *agentgateway.AgentgatewayPolic
 			if err != nil {
 				logger.Error("error parsing request prompt guard", "error", err)
 				errs = append(errs, err)
-			} else {
-				translatedAIPolicy.PromptGuard.Request = r
 			}
+			translatedAIPolicy.PromptGuard.Request = r
 		}
 
 		if aiSpec.PromptGuard.Response != nil {
@@ -802,9 +870,8 @@ func translateBackendAI(ctx PolicyCtx, agwPolicy *agentgateway.AgentgatewayPolic
 			if err != nil {
 				logger.Error("error parsing response prompt guard", "error", err)
 				errs = append(errs, err)
-			} else {
-				translatedAIPolicy.PromptGuard.Response = r
 			}
+			translatedAIPolicy.PromptGuard.Response = r
 		}
 	}
 
@@ -1097,7 +1164,7 @@ func BuildOAuthTokenExchange(ctx PolicyCtx, auth *agentgateway.OAuthTokenExchang
 	}
 
 	additionalParams := castCELMap(auth.AdditionalParams, func(key string, expr agentgateway.CELExpression) {
-		errs = append(errs, fmt.Errorf("oauth additionalParams %q is not a valid CEL expression: %s", key, expr))
+		errs = append(errs, categorizedErrorf(PolicyErrorCategoryInvalidValue, "oauth additionalParams %q is not a valid CEL expression: %s", key, expr))
 	})
 	for key := range auth.AdditionalParams {
 		if isOAuthReservedAdditionalParam(key) {
@@ -1236,7 +1303,7 @@ func buildOAuthClientAuth(ctx PolicyCtx, auth *agentgateway.OAuthClientAuth, nam
 	}
 
 	if auth.SecretRef != nil && auth.PrivateKeyJWT == nil {
-		data, key, err := ctx.ResolveCredentialKeyRef(*auth.SecretRef, namespace, wellknown.ClientSecret)
+		data, key, err := ctx.ResolveSecretKeyRef(*auth.SecretRef, namespace, wellknown.ClientSecret)
 		if err != nil {
 			clientSecret := ""
 			res.ClientSecret = &clientSecret
@@ -1244,7 +1311,7 @@ func buildOAuthClientAuth(ctx PolicyCtx, auth *agentgateway.OAuthClientAuth, nam
 		} else if value, exists := kubeutils.GetSecretDataValue(data, key); !exists || value == "" {
 			clientSecret := ""
 			res.ClientSecret = &clientSecret
-			errs = append(errs, fmt.Errorf("secret %s/%s missing %s value", namespace, auth.SecretRef.Name, key))
+			errs = append(errs, fmt.Errorf("secret %s/%s missing %s value", auth.SecretRef.ResolvedNamespace(namespace), auth.SecretRef.Name, key))
 		} else {
 			res.ClientSecret = &value
 		}
@@ -202,7 +202,7 @@ func translatePoliciesForBackendTLS(
 			}
 
 			policy := &api.Policy{
-				Key:    btls.Namespace + "/" + btls.Name + backendTlsPolicySuffix + attachmentName(policyTarget),
+				Key:    policyKey(btls.Namespace, btls.Name) + backendTlsPolicySuffix + attachmentName(policyTarget),
 				Name:   TypedResourceName(wellknown.BackendTLSPolicyKind, btls),
 				Target: policyTarget,
 				Kind: &api.Policy_Backend{
@@ -0,0 +1,46 @@
+package plugins
+
+import (
+	"sync"
+
+	"github.com/google/cel-go/cel"
+)
+
+// maxCELCacheEntries bounds the parsed expression cache. Expressions that are no longer referenced are never
+// looked up again, so the cache is simply reset once it fills rather than tracking recency.
+const maxCELCacheEntries = 4096
+
+// celCache memoizes CEL parse results keyed on the expression string, so unchanged expressions are not reparsed
+// on every reconcile. A changed expression is a different key and is parsed afresh.
+type celCache struct {
+	mu      sync.Mutex
+	entries map[string]celCacheEntry
+}
+
+type celCacheEntry struct {
+	ast *cel.Ast
+	err error
+}
+
+var parsedCEL = &celCache{}
+
+// parse returns the parsed expression, reporting whether it was served from the cache.
+func (c *celCache) parse(env *cel.Env, expr string) (*cel.Ast, bool, error) {
+	c.mu.Lock()
+	e, hit := c.entries[expr]
+	c.mu.Unlock()
+	if hit {
+		return e.ast, true, e.err
+	}
+
+	ast, iss := env.Parse(expr)
+	e = celCacheEntry{ast: ast, err: iss.Err()}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.entries == nil || len(c.entries) >= maxCELCacheEntries {
+		c.entries = make(map[string]celCacheEntry)
+	}
+	c.entries[expr] = e
+	return e.ast, false, e.err
+}
@@ -0,0 +1,51 @@
+package plugins
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestCELCacheReusesUnchangedExpression(t *testing.T) {
+	c := &celCache{}
+
+	first, hit, err := c.parse(celEnv, `request.path == "/a"`)
+	if err != nil || hit {
+		t.Fatalf("expected a miss on first parse, got hit=%v err=%v", hit, err)
+	}
+	again, hit, err := c.parse(celEnv, `request.path == "/a"`)
+	if err != nil || !hit {
+		t.Fatalf("expected a hit for an unchanged expression, got hit=%v err=%v", hit, err)
+	}
+	if again != first {
+		t.Fatalf("expected the cached parse result to be reused")
+	}
+
+	// Changing the expression misses and parses the new expression.
+	_, hit, err = c.parse(celEnv, `request.path == "/b"`)
+	if err != nil || hit {
+		t.Fatalf("expected a miss after the expression changed, got hit=%v err=%v", hit, err)
+	}
+}
+
+func TestCELCacheCachesParseErrors(t *testing.T) {
+	c := &celCache{}
+
+	if _, hit, err := c.parse(celEnv, `request.path ==`); err == nil || hit {
+		t.Fatalf("expected a parse error on a miss, got hit=%v err=%v", hit, err)
+	}
+	if _, hit, err := c.parse(celEnv, `request.path ==`); err == nil || !hit {
+		t.Fatalf("expected the cached parse error, got hit=%v err=%v", hit, err)
+	}
+}
+
+func TestCELCacheIsBounded(t *testing.T) {
+	c := &celCache{}
+	for i := range maxCELCacheEntries + 1 {
+		if _, _, err := c.parse(celEnv, strconv.Itoa(i)+" > 0"); err != nil {
+			t.Fatalf("unexpected parse error: %v", err)
+		}
+	}
+	if len(c.entries) > maxCELCacheEntries {
+		t.Fatalf("expected at most %d entries, got %d", maxCELCacheEntries, len(c.entries))
+	}
+}
@@ -1,6 +1,8 @@
 package plugins
 
 import (
+	"time"
+
 	networkingclient "istio.io/client-go/pkg/apis/networking/v1"
 	"istio.io/istio/pilot/pkg/serviceregistry/ambient"
 	"istio.io/istio/pkg/config/schema/gvr"
@@ -60,6 +62,7 @@ type AgwCollections struct {
 	GRPCRoutes              krt.Collection[*gwv1.GRPCRoute]
 	GRPCRoutesByNamespace   krt.Index[string, *gwv1.GRPCRoute]
 	TCPRoutes               krt.Collection[*gwv1.TCPRoute]
+	TCPRoutesByNamespace    krt.Index[string, *gwv1.TCPRoute]
 	TLSRoutes               krt.Collection[*gwv1.TLSRoute]
 	ReferenceGrants         krt.Collection[*gwv1b1.ReferenceGrant]
 	BackendTLSPolicies      krt.Collection[*gwv1.BackendTLSPolicy]
@@ -74,6 +77,10 @@ type AgwCollections struct {
 	Backends             krt.Collection[*agentgateway.AgentgatewayBackend]
 	BackendsByNamespace  krt.Index[string, *agentgateway.AgentgatewayBackend]
 	AgentgatewayPolicies krt.Collection[*agentgateway.AgentgatewayPolicy]
+	// AgentgatewayPoliciesByNamespace is used to detect policies conflicting on the same target.
+	AgentgatewayPoliciesByNamespace krt.Index[string, *agentgateway.AgentgatewayPolicy]
+	// PolicyScheduler re-triggers translation of policies with an activeSchedule at their boundaries.
+	PolicyScheduler *PolicyScheduler
 
 	// ControllerName is the name of the Gateway controller.
 	ControllerName string
@@ -228,9 +235,14 @@ func (c *AgwCollections) SetupIndexes() {
 	c.GatewaysByNamespace = krt.NewNamespaceIndex(c.Gateways)
 	c.HTTPRoutesByNamespace = krt.NewNamespaceIndex(c.HTTPRoutes)
 	c.GRPCRoutesByNamespace = krt.NewNamespaceIndex(c.GRPCRoutes)
+	c.TCPRoutesByNamespace = krt.NewNamespaceIndex(c.TCPRoutes)
 	c.ListenerSetsByNamespace = krt.NewNamespaceIndex(c.ListenerSets)
 	c.BackendsByNamespace = krt.NewNamespaceIndex(c.Backends)
 	c.InferencePoolsByNamespace = krt.NewNamespaceIndex(c.InferencePools)
+	c.AgentgatewayPoliciesByNamespace = krt.NewNamespaceIndex(c.AgentgatewayPolicies)
+	if c.PolicyScheduler == nil {
+		c.PolicyScheduler = NewPolicyScheduler(c.KrtOpts.Stop, time.Now)
+	}
 }
 
 func (c *AgwCollections) HasSynced() bool {
@@ -10,9 +10,12 @@ import (
 	"istio.io/istio/pkg/ptr"
 	"istio.io/istio/pkg/test"
 	"istio.io/istio/pkg/test/util/assert"
+	"istio.io/istio/pkg/util/sets"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/agentgateway/agentgateway/controller/api/v1alpha1/agentgateway"
 	"github.com/agentgateway/agentgateway/controller/pkg/utils/kubeutils"
@@ -289,6 +292,69 @@ func TestBasicAuthCustomResolverDoesNotImplicitlyFallbackToSecret(t *testing.T)
 	}
 }
 
+// secretGrants permits cross-namespace access to the listed Secrets only.
+type secretGrants struct {
+	ReferenceGrantChecker
+	allowed sets.Set[types.NamespacedName]
+}
+
+func (g secretGrants) SecretAllowed(_ krt.HandlerContext, _ schema.GroupVersionKind, secret types.NamespacedName, _ string) bool {
+	return g.allowed.Contains(secret)
+}
+
+func TestAPIKeyAuthCrossNamespaceSecretRequiresReferenceGrant(t *testing.T) {
+	stop := test.NewStop(t)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "credentials",
+			Name:      "api-keys",
+		},
+		Data: map[string][]byte{
+			"client1": []byte("k-123"),
+		},
+	}
+	secrets := krt.NewStaticCollection[*corev1.Secret](nil, []*corev1.Secret{secret}, krt.WithName("plugins/TestAPIKeyAuthCrossNamespaceSecretRequiresReferenceGrant"), krt.WithStop(stop))
+	auth := &agentgateway.APIKeyAuthentication{
+		SecretRef: &agentgateway.SecretObjectRef{
+			Name:      "api-keys",
+			Namespace: new(gwv1.Namespace("credentials")),
+		},
+	}
+	policyName := types.NamespacedName{Namespace: "default", Name: "policy"}
+
+	t.Run("permitted", func(t *testing.T) {
+		ctx := simpleAuthPolicyCtx(&AgwCollections{Secrets: secrets}, kubeutils.NewSecretCredentialResolver(secrets))
+		ctx.Grants = secretGrants{allowed: sets.New(types.NamespacedName{Namespace: "credentials", Name: "api-keys"})}
+
+		policy, err := processAPIKeyAuthenticationPolicy(ctx, auth, nil, "base", policyName)
+		assert.NoError(t, err)
+		keys := policy.GetTraffic().GetApiKeyAuth().GetApiKeys()
+		assert.Equal(t, len(keys), 1)
+		assert.Equal(t, keys[0].GetKey(), "k-123")
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		ctx := simpleAuthPolicyCtx(&AgwCollections{Secrets: secrets}, kubeutils.NewSecretCredentialResolver(secrets))
+		ctx.Grants = secretGrants{allowed: sets.New[types.NamespacedName]()}
+
+		policy, err := processAPIKeyAuthenticationPolicy(ctx, auth, nil, "base", policyName)
+		assert.Equal(t, len(policy.GetTraffic().GetApiKeyAuth().GetApiKeys()), 0)
+		category, ok := PolicyErrorCategoryOf(err)
+		if !ok || category.ConditionReason() != agentgateway.PolicyReasonReferenceNotPermitted {
+			t.Fatalf("processAPIKeyAuthenticationPolicy() error = %v, want ReferenceNotPermitted", err)
+		}
+	})
+
+	t.Run("no grant checker", func(t *testing.T) {
+		ctx := simpleAuthPolicyCtx(&AgwCollections{Secrets: secrets}, kubeutils.NewSecretCredentialResolver(secrets))
+
+		_, err := processAPIKeyAuthenticationPolicy(ctx, auth, nil, "base", policyName)
+		if err == nil || !strings.Contains(err.Error(), "cross-namespace credential refs are not supported") {
+			t.Fatalf("processAPIKeyAuthenticationPolicy() error = %v, want unsupported cross-namespace error", err)
+		}
+	})
+}
+
 func TestBackendAuthCustomKeyRejectsEmptyValue(t *testing.T) {
 	stop := test.NewStop(t)
 	secret := &corev1.Secret{
@@ -347,3 +413,71 @@ func (r configMapCredentialResolver) ResolveCredentialRef(krtctx krt.HandlerCont
 	}
 	return data, nil
 }
+
+// policyTranslation is the outcome of translating a policy inside a krt collection.
+type policyTranslation struct {
+	Name  string
+	Error string
+	Key   string
+}
+
+func (p policyTranslation) ResourceName() string {
+	return p.Name
+}
+
+func TestPolicyRetranslatesWhenReferencedSecretArrives(t *testing.T) {
+	stop := test.NewStop(t)
+	secrets := krt.NewStaticCollection[*corev1.Secret](nil, nil, krt.WithName("plugins/TestPolicyRetranslatesWhenReferencedSecretArrives/secrets"), krt.WithStop(stop))
+	policies := krt.NewStaticCollection(nil, []*agentgateway.AgentgatewayPolicy{{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "backend-auth",
+		},
+		Spec: agentgateway.AgentgatewayPolicySpec{
+			Backend: &agentgateway.BackendFull{
+				BackendSimple: agentgateway.BackendSimple{
+					Auth: &agentgateway.BackendAuth{
+						SecretRef: &agentgateway.LocalSecretKeyRef{Name: "late-secret"},
+					},
+				},
+			},
+		},
+	}}, krt.WithName("plugins/TestPolicyRetranslatesWhenReferencedSecretArrives/policies"), krt.WithStop(stop))
+	resolver := kubeutils.NewSecretCredentialResolver(secrets)
+
+	// Secret lookups go through the handler context, so the Secret becomes a
+	// dependency of the translation and its creation re-triggers it.
+	translations := krt.NewCollection(policies, func(krtctx krt.HandlerContext, policy *agentgateway.AgentgatewayPolicy) *policyTranslation {
+		ctx := PolicyCtx{
+			Krt:                krtctx,
+			Collections:        &AgwCollections{Secrets: secrets},
+			CredentialResolver: resolver,
+		}
+		res, err := TranslatePolicyToAgw(ctx, policy)
+		out := &policyTranslation{Name: policy.Namespace + "/" + policy.Name}
+		if err != nil {
+			out.Error = err.Error()
+		}
+		for _, p := range res {
+			if key := p.GetBackend().GetAuth().GetKey(); key != nil {
+				out.Key = key.GetSecret()
+			}
+		}
+		return out
+	}, krt.WithName("plugins/TestPolicyRetranslatesWhenReferencedSecretArrives/translations"), krt.WithStop(stop))
+
+	fetch := func() policyTranslation {
+		if r := translations.GetKey("default/backend-auth"); r != nil {
+			return *r
+		}
+		return policyTranslation{}
+	}
+	assert.EventuallyEqual(t, func() bool { return strings.Contains(fetch().Error, "late-secret") }, true)
+	assert.Equal(t, fetch().Key, "")
+
+	secrets.UpdateObject(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "late-secret"},
+		Data:       map[string][]byte{"Authorization": []byte("Bearer late-token")},
+	})
+	assert.EventuallyEqual(t, fetch, policyTranslation{Name: "default/backend-auth", Key: "late-token"})
+}
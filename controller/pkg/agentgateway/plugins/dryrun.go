@@ -0,0 +1,28 @@
+package plugins
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/agentgateway/agentgateway/controller/api/v1alpha1/agentgateway"
+)
+
+// ValidateAgentgatewayPolicy runs the same validation as TranslateAgentgatewayPolicy and returns the
+// conditions the policy would be reported with, without resolving targets or producing output policies.
+// This lets tooling, such as CI checks, gate policies before they are applied.
+func ValidateAgentgatewayPolicy(ctx PolicyCtx, policy *agentgateway.AgentgatewayPolicy) []metav1.Condition {
+	translated, err := TranslatePolicyToAgw(ctx, policy)
+	return setConditions(policy.Generation, nil, programmedConditionMap(PolicyConditionMap(err, len(translated) > 0)))
+}
+
+// validatePolicyCombinations rejects combinations of fields that cannot be translated together.
+// These mirror the CRD validation rules so policies that bypass admission, such as those validated
+// by ValidateAgentgatewayPolicy, are rejected consistently.
+func validatePolicyCombinations(policy *agentgateway.AgentgatewayPolicy) error {
+	spec := policy.Spec
+	if spec.Traffic != nil && spec.Traffic.JWTAuthentication != nil &&
+		spec.Backend != nil && spec.Backend.MCP != nil && spec.Backend.MCP.Authentication != nil {
+		return categorizedErrorf(PolicyErrorCategoryInvalidCombination,
+			"invalid auth mode combination: traffic.jwtAuthentication may not be used with backend.mcp.authentication in the same policy")
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package plugins
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/agentgateway/agentgateway/controller/api/v1alpha1/agentgateway"
+)
+
+func TestValidateAgentgatewayPolicyAcceptsValidPolicy(t *testing.T) {
+	policy := &agentgateway.AgentgatewayPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "timeout", Namespace: "default", Generation: 3},
+		Spec: agentgateway.AgentgatewayPolicySpec{
+			Traffic: &agentgateway.Traffic{
+				Timeouts: &agentgateway.Timeouts{Request: &metav1.Duration{Duration: 10 * time.Second}},
+			},
+		},
+	}
+
+	conds := ValidateAgentgatewayPolicy(oauthTestPolicyCtx(t), policy)
+
+	accepted := meta.FindStatusCondition(conds, string(agentgateway.PolicyConditionAccepted))
+	if accepted == nil || accepted.Status != metav1.ConditionTrue || accepted.Reason != string(agentgateway.PolicyReasonValid) {
+		t.Fatalf("expected policy to be accepted, got %+v", accepted)
+	}
+	if accepted.ObservedGeneration != 3 {
+		t.Fatalf("expected observed generation 3, got %d", accepted.ObservedGeneration)
+	}
+	programmed := meta.FindStatusCondition(conds, string(agentgateway.PolicyConditionProgrammed))
+	if programmed == nil || programmed.Status != metav1.ConditionTrue || programmed.Reason != string(agentgateway.PolicyReasonProgrammed) {
+		t.Fatalf("expected policy to be programmed, got %+v", programmed)
+	}
+}
+
+func TestValidateAgentgatewayPolicyRejectsConflictingAuth(t *testing.T) {
+	inline := `{"keys":[]}`
+	policy := &agentgateway.AgentgatewayPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "conflict", Namespace: "default"},
+		Spec: agentgateway.AgentgatewayPolicySpec{
+			Traffic: &agentgateway.Traffic{
+				JWTAuthentication: &agentgateway.JWTAuthentication{
+					Providers: []agentgateway.JWTProvider{{
+						Issuer: "https://issuer.example.com",
+						JWKS:   agentgateway.JWKS{Inline: &inline},
+					}},
+				},
+			},
+			Backend: &agentgateway.BackendFull{
+				MCP: &agentgateway.BackendMCP{
+					Authentication: &agentgateway.MCPAuthentication{Issuer: "https://issuer.example.com"},
+				},
+			},
+		},
+	}
+
+	conds := ValidateAgentgatewayPolicy(oauthTestPolicyCtx(t), policy)
+
+	accepted := meta.FindStatusCondition(conds, string(agentgateway.PolicyConditionAccepted))
+	if accepted == nil || accepted.Status != metav1.ConditionFalse || accepted.Reason != string(agentgateway.PolicyReasonInvalidCombination) {
+		t.Fatalf("expected policy to be rejected, got %+v", accepted)
+	}
+	if !strings.Contains(accepted.Message, "invalid auth mode combination") ||
+		!strings.Contains(accepted.Message, "traffic.jwtAuthentication may not be used with backend.mcp.authentication") {
+		t.Fatalf("unexpected rejection message: %q", accepted.Message)
+	}
+	if !strings.HasSuffix(accepted.Message, "[codes: "+string(PolicyErrorCategoryInvalidCombination)+"]") {
+		t.Fatalf("expected rejection message to carry code %s, got %q", PolicyErrorCategoryInvalidCombination, accepted.Message)
+	}
+	attached := meta.FindStatusCondition(conds, string(agentgateway.PolicyConditionAttached))
+	if attached == nil || attached.Status != metav1.ConditionFalse {
+		t.Fatalf("expected policy not to be attached, got %+v", attached)
+	}
+	programmed := meta.FindStatusCondition(conds, string(agentgateway.PolicyConditionProgrammed))
+	if programmed == nil || programmed.Status != metav1.ConditionFalse || programmed.Reason != string(agentgateway.PolicyReasonInvalid) {
+		t.Fatalf("expected policy not to be programmed, got %+v", programmed)
+	}
+}
@@ -93,7 +93,7 @@ func translateFrontendTracing(ctx PolicyCtx, policy *agentgateway.AgentgatewayPo
 	var errs []error
 	provider, err := BuildBackendRef(ctx, tracing.BackendRef, policy.Namespace)
 	if err != nil {
-		errs = append(errs, fmt.Errorf("failed to translate tracing backend ref: %v", err))
+		errs = append(errs, fmt.Errorf("failed to translate tracing backend ref: %w", err))
 	}
 
 	var addAttributes []*api.FrontendPolicySpec_TracingAttribute
@@ -101,7 +101,7 @@ func translateFrontendTracing(ctx PolicyCtx, policy *agentgateway.AgentgatewayPo
 	if tracing.Attributes != nil {
 		for _, add := range tracing.Attributes.Add {
 			if !isCEL(add.Expression) {
-				errs = append(errs, fmt.Errorf("frontend tracing attribute %q is not a valid CEL expression: %s", add.Name, add.Expression))
+				errs = append(errs, categorizedErrorf(PolicyErrorCategoryInvalidValue, "frontend tracing attribute %q is not a valid CEL expression: %s", add.Name, add.Expression))
 			}
 			addAttributes = append(addAttributes, &api.FrontendPolicySpec_TracingAttribute{
 				Name:  add.Name,
@@ -117,7 +117,7 @@ func translateFrontendTracing(ctx PolicyCtx, policy *agentgateway.AgentgatewayPo
 	if tracing.Resources != nil {
 		for _, add := range tracing.Resources {
 			if !isCEL(add.Expression) {
-				errs = append(errs, fmt.Errorf("frontend tracing resource %q is not a valid CEL expression: %s", add.Name, add.Expression))
+				errs = append(errs, categorizedErrorf(PolicyErrorCategoryInvalidValue, "frontend tracing resource %q is not a valid CEL expression: %s", add.Name, add.Expression))
 			}
 			addResources = append(addResources, &api.FrontendPolicySpec_TracingAttribute{
 				Name:  add.Name,
@@ -129,21 +129,21 @@ func translateFrontendTracing(ctx PolicyCtx, policy *agentgateway.AgentgatewayPo
 	var randomSampling *string
 	if tracing.RandomSampling != nil {
 		randomSampling = castCELPtr(tracing.RandomSampling, func(expr agentgateway.CELExpression) {
-			errs = append(errs, fmt.Errorf("frontend tracing randomSampling is not a valid CEL expression: %s", expr))
+			errs = append(errs, categorizedErrorf(PolicyErrorCategoryInvalidValue, "frontend tracing randomSampling is not a valid CEL expression: %s", expr))
 		})
 	}
 
 	var clientSampling *string
 	if tracing.ClientSampling != nil {
 		clientSampling = castCELPtr(tracing.ClientSampling, func(expr agentgateway.CELExpression) {
-			errs = append(errs, fmt.Errorf("frontend tracing clientSampling is not a valid CEL expression: %s", expr))
+			errs = append(errs, categorizedErrorf(PolicyErrorCategoryInvalidValue, "frontend tracing clientSampling is not a valid CEL expression: %s", expr))
 		})
 	}
 
 	var filter *string
 	if tracing.Filter != nil {
 		filter = castCELPtr(tracing.Filter, func(expr agentgateway.CELExpression) {
-			errs = append(errs, fmt.Errorf("frontend tracing filter is not a valid CEL expression: %s", expr))
+			errs = append(errs, categorizedErrorf(PolicyErrorCategoryInvalidValue, "frontend tracing filter is not a valid CEL expression: %s", expr))
 		})
 	}
 
@@ -196,14 +196,14 @@ func translateFrontendAccessLog(ctx PolicyCtx, policy *agentgateway.Agentgateway
 	var errs []error
 	if f := logging.Filter; f != nil {
 		spec.Filter = castCELPtr(f, func(expr agentgateway.CELExpression) {
-			errs = append(errs, fmt.Errorf("frontend accessLog filter is not a valid CEL expression: %s", expr))
+			errs = append(errs, categorizedErrorf(PolicyErrorCategoryInvalidValue, "frontend accessLog filter is not a valid CEL expression: %s", expr))
 		})
 	}
 	if a := logging.Attributes; a != nil {
 		fields := make([]*api.FrontendPolicySpec_Logging_Field, 0, len(a.Add))
 		for _, add := range a.Add {
 			if !isCEL(add.Expression) {
-				errs = append(errs, fmt.Errorf("frontend accessLog field %q is not a valid CEL expression: %s", add.Name, add.Expression))
+				errs = append(errs, categorizedErrorf(PolicyErrorCategoryInvalidValue, "frontend accessLog field %q is not a valid CEL expression: %s", add.Name, add.Expression))
 			}
 			fields = append(fields, &api.FrontendPolicySpec_Logging_Field{
 				Name:       add.Name,
@@ -216,10 +216,20 @@ func translateFrontendAccessLog(ctx PolicyCtx, policy *agentgateway.Agentgateway
 		}
 		spec.Fields = f
 	}
+	claims, err := translateAccessLogJWTClaims(logging.JWTClaims)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	if len(claims) > 0 {
+		if spec.Fields == nil {
+			spec.Fields = &api.FrontendPolicySpec_Logging_Fields{}
+		}
+		spec.Fields.Add = append(spec.Fields.Add, claims...)
+	}
 	if otlp := logging.Otlp; otlp != nil {
 		provider, err := BuildBackendRef(ctx, otlp.BackendRef, policy.Namespace)
 		if err != nil {
-			errs = append(errs, fmt.Errorf("failed to translate access log OTLP backend ref: %v", err))
+			errs = append(errs, fmt.Errorf("failed to translate access log OTLP backend ref: %w", err))
 		}
 
 		var protocol api.FrontendPolicySpec_Logging_OtlpAccessLog_Protocol
@@ -240,7 +250,7 @@ func translateFrontendAccessLog(ctx PolicyCtx, policy *agentgateway.Agentgateway
 		var filter *string
 		if f := otlp.Filter; f != nil {
 			filter = castCELPtr(f, func(expr agentgateway.CELExpression) {
-				errs = append(errs, fmt.Errorf("frontend accessLog OTLP filter is not a valid CEL expression: %s", expr))
+				errs = append(errs, categorizedErrorf(PolicyErrorCategoryInvalidValue, "frontend accessLog OTLP filter is not a valid CEL expression: %s", expr))
 			})
 		}
 
@@ -249,7 +259,7 @@ func translateFrontendAccessLog(ctx PolicyCtx, policy *agentgateway.Agentgateway
 			addedFields := make([]*api.FrontendPolicySpec_Logging_Field, 0, len(a.Add))
 			for _, add := range a.Add {
 				if !isCEL(add.Expression) {
-					errs = append(errs, fmt.Errorf("frontend accessLog OTLP field %q is not a valid CEL expression: %s", add.Name, add.Expression))
+					errs = append(errs, categorizedErrorf(PolicyErrorCategoryInvalidValue, "frontend accessLog OTLP field %q is not a valid CEL expression: %s", add.Name, add.Expression))
 				}
 				addedFields = append(addedFields, &api.FrontendPolicySpec_Logging_Field{
 					Name:       add.Name,
@@ -258,7 +268,7 @@ func translateFrontendAccessLog(ctx PolicyCtx, policy *agentgateway.Agentgateway
 			}
 			fields = &api.FrontendPolicySpec_Logging_Fields{
 				Remove: a.Remove,
-				Add:    addedFields,
+				Add:    append(addedFields, claims...),
 			}
 		}
 
@@ -290,6 +300,25 @@ func translateFrontendAccessLog(ctx PolicyCtx, policy *agentgateway.Agentgateway
 	return loggingPolicy, errors.Join(errs...)
 }
 
+// translateAccessLogJWTClaims renders each claim as a `jwt.<claim>` field. When no JWT was
+// validated for the request the expression fails to evaluate and the proxy omits the field.
+func translateAccessLogJWTClaims(claims []agentgateway.AccessLogJWTClaim) ([]*api.FrontendPolicySpec_Logging_Field, error) {
+	var errs []error
+	fields := make([]*api.FrontendPolicySpec_Logging_Field, 0, len(claims))
+	for _, c := range claims {
+		expr := "jwt." + c.Claim
+		if !isCEL(agentgateway.CELExpression(expr)) {
+			errs = append(errs, categorizedErrorf(PolicyErrorCategoryInvalidValue, "frontend accessLog jwtClaims %q is not a valid claim reference: %s", c.Name, c.Claim))
+			continue
+		}
+		fields = append(fields, &api.FrontendPolicySpec_Logging_Field{
+			Name:       c.Name,
+			Expression: expr,
+		})
+	}
+	return fields, errors.Join(errs...)
+}
+
 func translateFrontendTCP(policy *agentgateway.AgentgatewayPolicy, name string) *api.Policy {
 	tcp := policy.Spec.Frontend.TCP
 	spec := &api.FrontendPolicySpec_TCP{}
@@ -590,7 +619,6 @@ func translateFrontendHTTP(policy *agentgateway.AgentgatewayPolicy, name string)
 	if v := http.MaxConnectionDuration; v != nil {
 		spec.MaxConnectionDuration = durationpb.New(v.Duration)
 	}
-
 	httpPolicy := &api.Policy{
 		Key:  name + frontendHttpPolicySuffix,
 		Name: TypedResourceName(wellknown.AgentgatewayPolicyGVK.Kind, policy),
@@ -618,7 +646,7 @@ func translateFrontendMetrics(policy *agentgateway.AgentgatewayPolicy, name stri
 	fields := make([]*api.FrontendPolicySpec_Metrics_Field, 0, len(metricsSpec.Attributes.Add))
 	for _, add := range metricsSpec.Attributes.Add {
 		if !isCEL(add.Expression) {
-			errs = append(errs, fmt.Errorf("frontend metrics field %q is not a valid CEL expression: %s", add.Name, add.Expression))
+			errs = append(errs, categorizedErrorf(PolicyErrorCategoryInvalidValue, "frontend metrics field %q is not a valid CEL expression: %s", add.Name, add.Expression))
 		}
 		fields = append(fields, &api.FrontendPolicySpec_Metrics_Field{
 			Name:       add.Name,
@@ -2,6 +2,7 @@ package plugins
 
 import (
 	"cmp"
+	"errors"
 	"fmt"
 	"slices"
 	"strconv"
@@ -10,13 +11,16 @@ import (
 	"istio.io/istio/pkg/kube/controllers"
 	"istio.io/istio/pkg/kube/krt"
 	"istio.io/istio/pkg/ptr"
+	"istio.io/istio/pkg/util/sets"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
 	inf "sigs.k8s.io/gateway-api-inference-extension/api/v1"
 
 	"github.com/agentgateway/agentgateway/api"
+	"github.com/agentgateway/agentgateway/controller/api/annotations"
 	"github.com/agentgateway/agentgateway/controller/pkg/agentgateway/utils"
 	"github.com/agentgateway/agentgateway/controller/pkg/utils/kubeutils"
 	"github.com/agentgateway/agentgateway/controller/pkg/wellknown"
@@ -25,6 +29,8 @@ import (
 const (
 	defaultInferencePoolStatusKind = "Status"
 	defaultInferencePoolStatusName = "default"
+	// maxInferencePoolStatusParents mirrors the MaxItems validation on InferencePoolStatus.Parents.
+	maxInferencePoolStatusParents = 32
 )
 
 // NewInferencePlugin creates a new InferencePool policy plugin
@@ -34,8 +40,9 @@ func NewInferencePlugin(agw *AgwCollections) AgwPlugin {
 			wellknown.InferencePoolGVK.GroupKind(): {
 				Build: func(input PolicyPluginInput) (krt.StatusCollection[controllers.Object, any], krt.Collection[AgwPolicy]) {
 					status, policyCol := krt.NewStatusManyCollection(agw.InferencePools, func(krtctx krt.HandlerContext, infPool *inf.InferencePool) (*inf.InferencePoolStatus, []AgwPolicy) {
-						return translatePoliciesForInferencePool(krtctx, agw.ControllerName, input.References, agw.Services, infPool)
+						return translatePoliciesForInferencePool(krtctx, agw.ControllerName, input.References, agw.Services, infPool, !agw.Settings.DisableInferExtEndpointPickerTLS)
 					}, agw.KrtOpts.ToOptions("policies/InferencePool")...)
+					trackEmittedPolicies(wellknown.InferencePoolGVK.Kind, policyCol)
 					return ConvertStatusCollection(status, agw.KrtOpts.ToOptions, "policies/InferencePool"), policyCol
 				},
 			},
@@ -50,21 +57,32 @@ func translatePoliciesForInferencePool(
 	references ReferenceIndex,
 	services krt.Collection[*corev1.Service],
 	pool *inf.InferencePool,
+	endpointPickerTLS bool,
 ) (*inf.InferencePoolStatus, []AgwPolicy) {
+	finishMetrics := collectPolicyTranslationMetrics(wellknown.InferencePoolGVK.Kind)
 	var infPolicies []AgwPolicy
 
 	epr := pool.Spec.EndpointPickerRef
-	validationErr := validateInferencePoolEndpointPickerRef(krtctx, pool, services)
+	eppPort, validationErr := validateInferencePoolEndpointPickerRef(krtctx, pool, services)
+	eppSNI, configErr := inferencePoolEndpointPickerSNI(pool)
+	defer func() {
+		switch {
+		case validationErr != nil:
+			finishMetrics(string(inf.InferencePoolReasonInvalidExtensionRef))
+		case configErr != nil:
+			finishMetrics(string(inf.InferencePoolReasonNotSupportedByParent))
+		default:
+			finishMetrics("")
+		}
+	}()
 	attachedGateways := inferencePoolAttachedGateways(krtctx, references, pool)
-	status := buildInferencePoolStatus(pool, controllerName, attachedGateways, validationErr)
+	status := buildInferencePoolStatus(pool, controllerName, attachedGateways, validationErr, configErr)
 
 	// 'service/{namespace}/{hostname}:{port}'
 	hostname := kubeutils.GetInferenceServiceHostname(pool.Name, pool.Namespace)
-	eppPort := int32(0)
 	eppSvc := ""
 	endpointPicker := &api.BackendReference{}
 	if validationErr == nil {
-		eppPort = int32(epr.Port.Number)
 		eppSvc = kubeutils.GetServiceHostname(string(epr.Name), pool.Namespace)
 		endpointPicker = &api.BackendReference{
 			Kind: &api.BackendReference_Service_{
@@ -84,7 +102,7 @@ func translatePoliciesForInferencePool(
 
 	// Create the inference routing policy
 	inferencePolicy := &api.Policy{
-		Key:    pool.Namespace + "/" + pool.Name + ":inference",
+		Key:    policyKey(pool.Namespace, pool.Name) + ":inference",
 		Name:   TypedResourceName(wellknown.InferencePoolGVK.Kind, pool),
 		Target: &api.PolicyTarget{Kind: utils.ServiceTargetWithHostname(pool.Namespace, hostname, nil)},
 		Kind: &api.Policy_Backend{
@@ -112,10 +130,19 @@ func translatePoliciesForInferencePool(
 		return status, infPolicies
 	}
 
+	if !endpointPickerTLS {
+		// TLS to the endpoint picker is managed by user-provided BackendTLSPolicies.
+		logger.Debug("generated inference pool policies",
+			"pool", pool.Name,
+			"namespace", pool.Namespace,
+			"inference_policy", inferencePolicy.Name)
+		return status, infPolicies
+	}
+
 	// Create the TLS policy for the endpoint picker
 	// TODO: we would want some way if they explicitly set a BackendTLSPolicy for the EPP to respect that
 	inferencePolicyTLS := &api.Policy{
-		Key:    pool.Namespace + "/" + pool.Name + ":inferencetls",
+		Key:    policyKey(pool.Namespace, pool.Name) + ":inferencetls",
 		Name:   TypedResourceName(wellknown.InferencePoolGVK.Kind, pool),
 		Target: &api.PolicyTarget{Kind: utils.ServiceTargetWithHostname(pool.Namespace, eppSvc, new(strconv.Itoa(int(eppPort))))},
 		Kind: &api.Policy_Backend{
@@ -124,6 +151,7 @@ func translatePoliciesForInferencePool(
 					BackendTls: &api.BackendPolicySpec_BackendTLS{
 						// The spec mandates this :vomit:
 						Verification: api.BackendPolicySpec_BackendTLS_INSECURE_ALL,
+						Hostname:     eppSNI,
 					},
 				},
 			},
@@ -140,12 +168,14 @@ func translatePoliciesForInferencePool(
 	return status, infPolicies
 }
 
-func validateInferencePoolEndpointPickerRef(krtctx krt.HandlerContext, pool *inf.InferencePool, services krt.Collection[*corev1.Service]) error {
+// validateInferencePoolEndpointPickerRef validates the endpoint picker reference and returns the
+// resolved Service port number.
+func validateInferencePoolEndpointPickerRef(krtctx krt.HandlerContext, pool *inf.InferencePool, services krt.Collection[*corev1.Service]) (int32, error) {
 	epr := pool.Spec.EndpointPickerRef
-	var errs []string
+	var errs []error
 
 	if epr.Group != nil && *epr.Group != "" {
-		errs = append(errs, fmt.Sprintf("endpointPickerRef.group must be empty, got %q", *epr.Group))
+		errs = append(errs, categorizedErrorf(PolicyErrorCategoryInvalidValue, "endpointPickerRef.group must be empty, got %q", *epr.Group))
 	}
 
 	kind := epr.Kind
@@ -154,49 +184,101 @@ func validateInferencePoolEndpointPickerRef(krtctx krt.HandlerContext, pool *inf
 		kind = wellknown.ServiceKind
 	}
 	if kind != wellknown.ServiceKind {
-		errs = append(errs, fmt.Sprintf("endpointPickerRef.kind must be %q, got %q", wellknown.ServiceKind, kind))
+		errs = append(errs, categorizedErrorf(PolicyErrorCategoryInvalidValue, "endpointPickerRef.kind must be %q, got %q", wellknown.ServiceKind, kind))
 	}
 
-	if epr.Port == nil {
-		errs = append(errs, "endpointPickerRef.port must be specified")
-		return inferencePoolValidationError(errs)
+	portName, byName := pool.Annotations[annotations.EndpointPickerPortName]
+	if byName && portName == "" {
+		errs = append(errs, categorizedErrorf(PolicyErrorCategoryInvalidValue, "%s annotation must not be empty", annotations.EndpointPickerPortName))
+		return 0, inferencePoolValidationError(errs)
+	}
+	if epr.Port == nil && !byName {
+		errs = append(errs, categorizedErrorf(PolicyErrorCategoryInvalidValue, "endpointPickerRef.port must be specified"))
+		return 0, inferencePoolValidationError(errs)
 	}
 
 	svc := ptr.Flatten(krt.FetchOne(krtctx, services, krt.FilterKey(types.NamespacedName{Namespace: pool.Namespace, Name: string(epr.Name)}.String())))
 	if svc == nil {
-		errs = append(errs, fmt.Sprintf("endpointPickerRef Service %s/%s not found", pool.Namespace, epr.Name))
-		return inferencePoolValidationError(errs)
+		errs = append(errs, categorizedErrorf(PolicyErrorCategoryReferenceNotFound, "endpointPickerRef Service %s/%s not found", pool.Namespace, epr.Name))
+		return 0, inferencePoolValidationError(errs)
 	}
 
 	if svc.Spec.Type == corev1.ServiceTypeExternalName {
-		errs = append(errs, "endpointPickerRef Service must not be ExternalName")
+		errs = append(errs, categorizedErrorf(PolicyErrorCategoryInvalidValue, "endpointPickerRef Service must not be ExternalName"))
 	}
 
 	// Service must expose the requested TCP port.
 	foundTCPPort := false
-	eppPort := int32(epr.Port.Number)
+	eppPort := int32(0)
+	if !byName {
+		eppPort = int32(epr.Port.Number)
+	}
 	for _, sp := range svc.Spec.Ports {
 		proto := sp.Protocol
 		if proto == "" {
 			proto = corev1.ProtocolTCP
 		}
-		if sp.Port == eppPort && proto == corev1.ProtocolTCP {
+		if proto != corev1.ProtocolTCP {
+			continue
+		}
+		if byName && sp.Name == portName {
+			eppPort = sp.Port
+			foundTCPPort = true
+			break
+		}
+		if !byName && sp.Port == eppPort {
 			foundTCPPort = true
 			break
 		}
 	}
 	if !foundTCPPort {
-		errs = append(errs, fmt.Sprintf("endpointPickerRef.port %d must reference a TCP Service port on %s/%s", eppPort, pool.Namespace, epr.Name))
+		if byName {
+			errs = append(errs, categorizedErrorf(PolicyErrorCategoryInvalidValue, "endpointPickerRef port name %q must reference a TCP Service port on %s/%s", portName, pool.Namespace, epr.Name))
+		} else {
+			errs = append(errs, categorizedErrorf(PolicyErrorCategoryInvalidValue, "endpointPickerRef.port %d must reference a TCP Service port on %s/%s", eppPort, pool.Namespace, epr.Name))
+		}
 	}
 
-	return inferencePoolValidationError(errs)
+	if len(errs) > 0 {
+		return 0, inferencePoolValidationError(errs)
+	}
+	return eppPort, nil
 }
 
-func inferencePoolValidationError(errs []string) error {
+// inferencePoolEndpointPickerSNI parses the endpoint picker SNI override annotation. An
+// invalid value is reported and ignored, leaving the SNI derived from the picker's hostname.
+func inferencePoolEndpointPickerSNI(pool *inf.InferencePool) (*string, error) {
+	raw, ok := pool.Annotations[annotations.EndpointPickerSNI]
+	if !ok {
+		return nil, nil
+	}
+	if len(validation.IsDNS1123Subdomain(raw)) > 0 {
+		return nil, categorizedErrorf(PolicyErrorCategoryInvalidValue, "%s annotation is not a valid DNS name: %q", annotations.EndpointPickerSNI, raw)
+	}
+	return &raw, nil
+}
+
+func inferencePoolValidationError(errs []error) error {
 	if len(errs) == 0 {
 		return nil
 	}
-	return fmt.Errorf("%s", strings.Join(errs, "; "))
+	return inferencePoolErrors(errs)
+}
+
+// inferencePoolErrors reports validation errors on a single line while keeping each error, and
+// its PolicyErrorCategory, reachable through errors.Is and errors.As.
+type inferencePoolErrors []error
+
+func (e inferencePoolErrors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, err := range e {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (e inferencePoolErrors) Unwrap() []error {
+	return e
 }
 
 func inferencePoolAttachedGateways(
@@ -225,6 +307,7 @@ func buildInferencePoolStatus(
 	controllerName string,
 	attachedGateways map[types.NamespacedName]struct{},
 	validationErr error,
+	configErr error,
 ) *inf.InferencePoolStatus {
 	status := pool.Status.DeepCopy()
 	if status == nil {
@@ -232,20 +315,33 @@ func buildInferencePoolStatus(
 	}
 
 	existingOurs := make(map[string]inf.ParentStatus)
+	seen := sets.New[string]()
 	mergedParents := make([]inf.ParentStatus, 0, len(status.Parents)+len(attachedGateways)+1)
 	for _, p := range status.Parents {
+		key := inferencePoolParentMergeKey(p.ParentRef)
 		if string(p.ControllerName) != controllerName {
-			mergedParents = append(mergedParents, p)
+			// Parents owned by other controllers are carried over untouched; only their exact
+			// duplicates (same controller and parent) are dropped, keeping the first occurrence.
+			if !seen.InsertContains(string(p.ControllerName) + "/" + key) {
+				mergedParents = append(mergedParents, p)
+			}
 			continue
 		}
-		existingOurs[inferencePoolParentMergeKey(p.ParentRef)] = p
+		if _, found := existingOurs[key]; !found {
+			existingOurs[key] = p
+		}
 	}
 
-	conditions := inferencePoolConditionMap(controllerName, validationErr)
-	for _, ref := range desiredInferencePoolParentRefs(attachedGateways, validationErr) {
+	conditions := inferencePoolConditionMap(controllerName, validationErr, configErr)
+	for _, ref := range desiredInferencePoolParentRefs(attachedGateways, errors.Join(validationErr, configErr)) {
+		key := inferencePoolParentMergeKey(ref)
+		if seen.InsertContains(controllerName + "/" + key) {
+			continue
+		}
 		existingConds := []metav1.Condition(nil)
-		if existing, found := existingOurs[inferencePoolParentMergeKey(ref)]; found {
-			existingConds = existing.Conditions
+		if existing, found := existingOurs[key]; found {
+			// setConditions updates in place; clone so the update can never alias another parent.
+			existingConds = slices.Clone(existing.Conditions)
 		}
 		mergedParents = append(mergedParents, inf.ParentStatus{
 			ParentRef:      ref,
@@ -254,6 +350,16 @@ func buildInferencePoolStatus(
 		})
 	}
 
+	// Foreign parents come first, so any truncation only drops parents owned by our controller.
+	if len(mergedParents) > maxInferencePoolStatusParents {
+		logger.Warn("inference pool status parents exceed max status size, truncating",
+			"pool", pool.Name,
+			"namespace", pool.Namespace,
+			"parents", len(mergedParents),
+			"max", maxInferencePoolStatusParents)
+		mergedParents = mergedParents[:maxInferencePoolStatusParents]
+	}
+
 	status.Parents = mergedParents
 	return status
 }
@@ -292,7 +398,7 @@ func desiredInferencePoolParentRefs(attachedGateways map[types.NamespacedName]st
 	return refs
 }
 
-func inferencePoolConditionMap(controllerName string, validationErr error) map[string]*Condition {
+func inferencePoolConditionMap(controllerName string, validationErr, configErr error) map[string]*Condition {
 	msg := "InferencePool has been accepted"
 	if controllerName != "" {
 		msg = fmt.Sprintf("InferencePool has been accepted by controller %s", controllerName)
@@ -308,6 +414,12 @@ func inferencePoolConditionMap(controllerName string, validationErr error) map[s
 			Message: "All InferencePool references have been resolved",
 		},
 	}
+	if configErr != nil {
+		conds[string(inf.InferencePoolConditionAccepted)].Error = &ConfigError{
+			Reason:  string(inf.InferencePoolReasonNotSupportedByParent),
+			Message: "error: " + configErr.Error(),
+		}
+	}
 	if validationErr != nil {
 		conds[string(inf.InferencePoolConditionResolvedRefs)].Error = &ConfigError{
 			Reason:  string(inf.InferencePoolReasonInvalidExtensionRef),
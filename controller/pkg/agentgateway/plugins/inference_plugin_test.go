@@ -0,0 +1,174 @@
+package plugins
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"istio.io/istio/pkg/ptr"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	inf "sigs.k8s.io/gateway-api-inference-extension/api/v1"
+
+	"github.com/agentgateway/agentgateway/controller/pkg/wellknown"
+)
+
+const testInferenceControllerName = "agentgateway.dev/controller"
+
+func testInferencePool(parents ...inf.ParentStatus) *inf.InferencePool {
+	return &inf.InferencePool{
+		ObjectMeta: metav1.ObjectMeta{Name: "pool", Namespace: "default", Generation: 2},
+		Status:     inf.InferencePoolStatus{Parents: parents},
+	}
+}
+
+func testInferenceGatewayParent(controller, namespace, name string, group *inf.Group) inf.ParentStatus {
+	return inf.ParentStatus{
+		ParentRef: inf.ParentReference{
+			Group:     group,
+			Kind:      wellknown.GatewayKind,
+			Namespace: inf.Namespace(namespace),
+			Name:      inf.ObjectName(name),
+		},
+		ControllerName: inf.ControllerName(controller),
+	}
+}
+
+func TestBuildInferencePoolStatusDeduplicatesParents(t *testing.T) {
+	gatewayGroup := ptr.Of(inf.Group(wellknown.GatewayGroup))
+	pool := testInferencePool(
+		testInferenceGatewayParent(testInferenceControllerName, "default", "gw", gatewayGroup),
+		// Same gateway, relying on API defaulting for the group.
+		testInferenceGatewayParent(testInferenceControllerName, "default", "gw", nil),
+		testInferenceGatewayParent("other.dev/controller", "default", "gw", gatewayGroup),
+		testInferenceGatewayParent("other.dev/controller", "default", "gw", gatewayGroup),
+	)
+	attached := map[types.NamespacedName]struct{}{
+		{Namespace: "default", Name: "gw"}: {},
+	}
+
+	got := buildInferencePoolStatus(pool, testInferenceControllerName, attached, nil, nil)
+
+	if len(got.Parents) != 2 {
+		t.Fatalf("expected 2 parents, got %d: %+v", len(got.Parents), got.Parents)
+	}
+	counts := map[inf.ControllerName]int{}
+	for _, p := range got.Parents {
+		counts[p.ControllerName]++
+	}
+	if counts[testInferenceControllerName] != 1 {
+		t.Fatalf("expected a single parent for our controller, got %d", counts[testInferenceControllerName])
+	}
+	if counts["other.dev/controller"] != 1 {
+		t.Fatalf("expected a single parent for the foreign controller, got %d", counts["other.dev/controller"])
+	}
+}
+
+func TestBuildInferencePoolStatusCapsParents(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		attached int
+		want     int
+	}{
+		{name: "at cap", attached: maxInferencePoolStatusParents, want: maxInferencePoolStatusParents},
+		{name: "over cap", attached: maxInferencePoolStatusParents + 1, want: maxInferencePoolStatusParents},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			foreign := testInferenceGatewayParent("other.dev/controller", "default", "foreign", nil)
+			attached := make(map[types.NamespacedName]struct{}, tc.attached)
+			for i := range tc.attached - 1 {
+				attached[types.NamespacedName{Namespace: "default", Name: fmt.Sprintf("gw-%02d", i)}] = struct{}{}
+			}
+
+			got := buildInferencePoolStatus(testInferencePool(foreign), testInferenceControllerName, attached, nil, nil)
+
+			if len(got.Parents) != tc.want {
+				t.Fatalf("expected %d parents, got %d", tc.want, len(got.Parents))
+			}
+			if got.Parents[0].ControllerName != "other.dev/controller" {
+				t.Fatalf("expected foreign parent to be retained, got %+v", got.Parents[0])
+			}
+		})
+	}
+}
+
+func TestBuildInferencePoolStatusPreservesForeignConditions(t *testing.T) {
+	gatewayGroup := ptr.Of(inf.Group(wellknown.GatewayGroup))
+	foreign := testInferenceGatewayParent("other.dev/controller", "default", "gw", gatewayGroup)
+	foreign.Conditions = []metav1.Condition{
+		{
+			Type:               string(inf.InferencePoolConditionAccepted),
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: 1,
+			LastTransitionTime: metav1.Unix(1000, 0),
+			Reason:             "OtherReason",
+			Message:            "set by another controller",
+		},
+		{
+			Type:               "Custom",
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: 1,
+			LastTransitionTime: metav1.Unix(1000, 0),
+			Reason:             "Custom",
+			Message:            "custom condition",
+		},
+	}
+	ours := testInferenceGatewayParent(testInferenceControllerName, "default", "gw", gatewayGroup)
+	ours.Conditions = []metav1.Condition{{
+		Type:               string(inf.InferencePoolConditionResolvedRefs),
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: 1,
+		LastTransitionTime: metav1.Unix(1000, 0),
+		Reason:             string(inf.InferencePoolReasonResolvedRefs),
+		Message:            "All InferencePool references have been resolved",
+	}}
+	pool := testInferencePool(foreign, ours)
+	want := foreign.DeepCopy()
+	attached := map[types.NamespacedName]struct{}{
+		{Namespace: "default", Name: "gw"}: {},
+	}
+
+	got := buildInferencePoolStatus(pool, testInferenceControllerName, attached, fmt.Errorf("bad ref"), nil)
+
+	if len(got.Parents) != 2 {
+		t.Fatalf("expected 2 parents, got %d: %+v", len(got.Parents), got.Parents)
+	}
+	if !reflect.DeepEqual(got.Parents[0], *want) {
+		t.Fatalf("foreign parent was modified:\ngot:  %+v\nwant: %+v", got.Parents[0], *want)
+	}
+	resolved := meta.FindStatusCondition(got.Parents[1].Conditions, string(inf.InferencePoolConditionResolvedRefs))
+	if resolved == nil || resolved.Status != metav1.ConditionFalse || resolved.Reason != string(inf.InferencePoolReasonInvalidExtensionRef) {
+		t.Fatalf("expected our ResolvedRefs condition to be updated, got %+v", resolved)
+	}
+	if !reflect.DeepEqual(pool.Status.Parents[0], *want) {
+		t.Fatalf("input pool status was modified: %+v", pool.Status.Parents[0])
+	}
+}
+
+func TestInferencePoolErrorCategories(t *testing.T) {
+	err := inferencePoolValidationError([]error{
+		categorizedErrorf(PolicyErrorCategoryReferenceNotFound, "endpointPickerRef Service %s/%s not found", "ns", "epp"),
+	})
+	if got, ok := PolicyErrorCategoryOf(err); !ok || got != PolicyErrorCategoryReferenceNotFound {
+		t.Fatalf("PolicyErrorCategoryOf() = %q, %v, want %q", got, ok, PolicyErrorCategoryReferenceNotFound)
+	}
+
+	err = inferencePoolValidationError([]error{
+		categorizedErrorf(PolicyErrorCategoryInvalidValue, "endpointPickerRef.group must be empty, got %q", "foo"),
+		categorizedErrorf(PolicyErrorCategoryReferenceNotFound, "endpointPickerRef Service %s/%s not found", "ns", "epp"),
+	})
+	if want := `endpointPickerRef.group must be empty, got "foo"; endpointPickerRef Service ns/epp not found`; err.Error() != want {
+		t.Fatalf("Error() = %q, want %q", err.Error(), want)
+	}
+	if got, ok := PolicyErrorCategoryOf(err); ok {
+		t.Fatalf("expected mixed categories to have no shared category, got %q", got)
+	}
+
+	// InferencePool condition reasons are defined by the Inference Extension API, so categories
+	// do not change them.
+	conds := inferencePoolConditionMap("", err, nil)
+	if got := conds[string(inf.InferencePoolConditionResolvedRefs)].Error.Reason; got != string(inf.InferencePoolReasonInvalidExtensionRef) {
+		t.Fatalf("ResolvedRefs reason = %s, want %s", got, inf.InferencePoolReasonInvalidExtensionRef)
+	}
+}
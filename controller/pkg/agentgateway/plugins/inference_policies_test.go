@@ -0,0 +1,108 @@
+package plugins_test
+
+import (
+	"testing"
+
+	"istio.io/istio/pkg/ptr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	inf "sigs.k8s.io/gateway-api-inference-extension/api/v1"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/agentgateway/agentgateway/api"
+	apisettings "github.com/agentgateway/agentgateway/controller/api/settings"
+	"github.com/agentgateway/agentgateway/controller/pkg/agentgateway/plugins"
+	"github.com/agentgateway/agentgateway/controller/pkg/agentgateway/testutils"
+	"github.com/agentgateway/agentgateway/controller/pkg/wellknown"
+)
+
+func inferencePoolPolicies(t *testing.T, inputs ...any) []plugins.AgwPolicy {
+	t.Helper()
+	return inferencePoolPoliciesWithSettings(t, apisettings.Settings{}, inputs...)
+}
+
+func inferencePoolPoliciesWithSettings(t *testing.T, settings apisettings.Settings, inputs ...any) []plugins.AgwPolicy {
+	t.Helper()
+	ctx := testutils.BuildMockPolicyContext(t, inputs)
+	ctx.Collections.Settings = settings
+	plugin := plugins.NewInferencePlugin(ctx.Collections).ContributesPolicies[wellknown.InferencePoolGVK.GroupKind()]
+	_, policies := plugin.Build(plugins.PolicyPluginInput{References: ctx.References, Grants: ctx.Grants})
+	policies.WaitUntilSynced(ctx.Collections.KrtOpts.Stop)
+	return policies.List()
+}
+
+func inferenceRouting(policies []plugins.AgwPolicy) []*api.BackendPolicySpec_InferenceRouting {
+	var out []*api.BackendPolicySpec_InferenceRouting
+	for _, p := range policies {
+		if ir := p.Policy.GetBackend().GetInferenceRouting(); ir != nil {
+			out = append(out, ir)
+		}
+	}
+	return out
+}
+
+func endpointPickerTLS(policies []plugins.AgwPolicy) []*api.BackendPolicySpec_BackendTLS {
+	var out []*api.BackendPolicySpec_BackendTLS
+	for _, p := range policies {
+		if tls := p.Policy.GetBackend().GetBackendTls(); tls != nil {
+			out = append(out, tls)
+		}
+	}
+	return out
+}
+
+func inferencePoolTestInputs() []any {
+	route := &gwv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: "route", Namespace: "default"},
+		Spec: gwv1.HTTPRouteSpec{
+			CommonRouteSpec: gwv1.CommonRouteSpec{ParentRefs: []gwv1.ParentReference{{Name: "gw"}}},
+			Rules: []gwv1.HTTPRouteRule{{
+				BackendRefs: []gwv1.HTTPBackendRef{{BackendRef: gwv1.BackendRef{BackendObjectReference: gwv1.BackendObjectReference{
+					Group: ptr.Of(gwv1.Group(wellknown.InferencePoolGVK.Group)),
+					Kind:  ptr.Of(gwv1.Kind(wellknown.InferencePoolKind)),
+					Name:  "pool",
+				}}}},
+			}},
+		},
+	}
+	picker := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "pool-epp", Namespace: "default"},
+		Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Name: "grpc", Port: 9002}}},
+	}
+	pool := &inf.InferencePool{
+		ObjectMeta: metav1.ObjectMeta{Name: "pool", Namespace: "default"},
+		Spec: inf.InferencePoolSpec{
+			Selector:    inf.LabelSelector{MatchLabels: map[inf.LabelKey]inf.LabelValue{"app": "model"}},
+			TargetPorts: []inf.Port{{Number: 8080}},
+			EndpointPickerRef: inf.EndpointPickerRef{
+				Kind: "Service",
+				Name: "pool-epp",
+				Port: &inf.Port{Number: 9002},
+			},
+		},
+	}
+
+	return []any{route, picker, pool}
+}
+
+func TestInferencePoolEndpointPickerTLS(t *testing.T) {
+	t.Run("emitted by default", func(t *testing.T) {
+		tls := endpointPickerTLS(inferencePoolPolicies(t, inferencePoolTestInputs()...))
+		if len(tls) != 1 {
+			t.Fatalf("expected one endpoint picker TLS policy, got %d", len(tls))
+		}
+		if got := tls[0].GetVerification(); got != api.BackendPolicySpec_BackendTLS_INSECURE_ALL {
+			t.Fatalf("expected INSECURE_ALL verification, got %v", got)
+		}
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		policies := inferencePoolPoliciesWithSettings(t, apisettings.Settings{DisableInferExtEndpointPickerTLS: true}, inferencePoolTestInputs()...)
+		if tls := endpointPickerTLS(policies); len(tls) != 0 {
+			t.Fatalf("expected no endpoint picker TLS policy, got %d", len(tls))
+		}
+		if routing := inferenceRouting(policies); len(routing) != 1 {
+			t.Fatalf("expected the inference routing policy to still be emitted, got %d", len(routing))
+		}
+	})
+}
@@ -62,10 +62,23 @@ func (p AgwPolicy) Equals(in AgwPolicy) bool {
 	return ptr.Equal(p.Gateway, in.Gateway) && protoconv.Equals(p.Policy, in.Policy)
 }
 
+// ResourceName includes the object the policy was translated from, so policies from distinct
+// objects that share a key are kept apart and the collision can be detected.
 func (p AgwPolicy) ResourceName() string {
+	return p.gatewayKey() + "/" + p.source()
+}
+
+// gatewayKey is the identity of the policy as sent to its Gateway.
+func (p AgwPolicy) gatewayKey() string {
 	return p.Gateway.String() + "/" + p.Policy.Key
 }
 
+// source identifies the object the policy was translated from.
+func (p AgwPolicy) source() string {
+	n := p.Policy.GetName()
+	return n.GetKind() + "/" + n.GetNamespace() + "/" + n.GetName()
+}
+
 type AddResourcesPlugin struct {
 	Binds            krt.Collection[ir.AgwResource]
 	Listeners        krt.Collection[ir.AgwResource]
@@ -0,0 +1,119 @@
+package plugins
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	jose "github.com/go-jose/go-jose/v4"
+
+	"github.com/agentgateway/agentgateway/controller/api/v1alpha1/agentgateway"
+)
+
+const (
+	pemPublicKeyBlock    = "PUBLIC KEY"
+	pemRSAPublicKeyBlock = "RSA PUBLIC KEY"
+	pemCertificateBlock  = "CERTIFICATE"
+)
+
+// translateInlineJWKS validates inline key material and converts it to the
+// JSON Web Key Set the data plane expects. JWK Sets are passed through as-is.
+func translateInlineJWKS(inline string, format *agentgateway.JWKSFormat) (string, error) {
+	f := detectJWKSFormat(inline)
+	if format != nil {
+		f = *format
+	}
+
+	switch f {
+	case agentgateway.JWKSFormatJWKSet:
+		var set jose.JSONWebKeySet
+		if err := json.Unmarshal([]byte(inline), &set); err != nil {
+			return "", fmt.Errorf("invalid JWK Set: %w", err)
+		}
+		return inline, nil
+	case agentgateway.JWKSFormatPEM, agentgateway.JWKSFormatX509:
+		keys, err := parsePEMJWKs([]byte(inline), f)
+		if err != nil {
+			return "", err
+		}
+		out, err := json.Marshal(jose.JSONWebKeySet{Keys: keys})
+		if err != nil {
+			return "", err
+		}
+		return string(out), nil
+	default:
+		return "", fmt.Errorf("unsupported format %q", f)
+	}
+}
+
+// detectJWKSFormat guesses the encoding of inline key material from its first
+// PEM block, falling back to a JWK Set.
+func detectJWKSFormat(inline string) agentgateway.JWKSFormat {
+	block, _ := pem.Decode([]byte(inline))
+	switch {
+	case block == nil:
+		return agentgateway.JWKSFormatJWKSet
+	case block.Type == pemCertificateBlock:
+		return agentgateway.JWKSFormatX509
+	default:
+		return agentgateway.JWKSFormatPEM
+	}
+}
+
+func parsePEMJWKs(data []byte, format agentgateway.JWKSFormat) ([]jose.JSONWebKey, error) {
+	var keys []jose.JSONWebKey
+	for i := 0; ; i++ {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		key := jose.JSONWebKey{Use: "sig"}
+		switch {
+		case format == agentgateway.JWKSFormatX509 && block.Type == pemCertificateBlock:
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				// ASN.1 errors are not actionable, so report only the block.
+				return nil, fmt.Errorf("PEM block %d is not a valid X.509 certificate", i)
+			}
+			key.Key = cert.PublicKey
+			key.Certificates = []*x509.Certificate{cert}
+		case format == agentgateway.JWKSFormatPEM && block.Type == pemPublicKeyBlock:
+			pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("PEM block %d is not a valid public key", i)
+			}
+			key.Key = pub
+		case format == agentgateway.JWKSFormatPEM && block.Type == pemRSAPublicKeyBlock:
+			pub, err := x509.ParsePKCS1PublicKey(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("PEM block %d is not a valid public key", i)
+			}
+			key.Key = pub
+		default:
+			return nil, fmt.Errorf("unexpected PEM block %d of type %q for format %s", i, block.Type, format)
+		}
+		if !key.Valid() {
+			return nil, fmt.Errorf("unsupported key type %T in PEM block %d", key.Key, i)
+		}
+		// The data plane selects keys by `kid`, so derive a stable one from the key.
+		thumbprint, err := key.Thumbprint(crypto.SHA256)
+		if err != nil {
+			return nil, fmt.Errorf("unsupported key in PEM block %d: %w", i, err)
+		}
+		key.KeyID = base64.RawURLEncoding.EncodeToString(thumbprint)
+		keys = append(keys, key)
+	}
+	if len(bytes.TrimSpace(data)) > 0 {
+		return nil, errors.New("trailing data after PEM blocks")
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no PEM blocks found for format %s", format)
+	}
+	return keys, nil
+}
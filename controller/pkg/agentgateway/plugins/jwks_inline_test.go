@@ -0,0 +1,150 @@
+package plugins
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	jose "github.com/go-jose/go-jose/v4"
+	"istio.io/istio/pkg/ptr"
+
+	"github.com/agentgateway/agentgateway/controller/api/v1alpha1/agentgateway"
+)
+
+func TestTranslateInlineJWKS(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkix, err := x509.MarshalPKIXPublicKey(&ecKey.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	cert, err := x509.CreateCertificate(rand.Reader, template, template, &rsaKey.PublicKey, rsaKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	publicKeyPEM := string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pkix}))
+	rsaPublicKeyPEM := string(pem.EncodeToMemory(&pem.Block{Type: "RSA PUBLIC KEY", Bytes: x509.MarshalPKCS1PublicKey(&rsaKey.PublicKey)}))
+	certificatePEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert}))
+	jwkSet := `{"keys":[{"kty":"RSA","e":"AQAB","use":"sig","kid":"test-key","alg":"RS256","n":"test"}]}`
+
+	for _, tc := range []struct {
+		name      string
+		inline    string
+		format    *agentgateway.JWKSFormat
+		wantKeys  []string
+		wantCerts bool
+		wantSame  bool
+		wantErr   string
+	}{
+		{
+			name:     "jwk set is passed through",
+			inline:   jwkSet,
+			wantKeys: []string{"RSA"},
+			wantSame: true,
+		},
+		{
+			name:     "pem public key is detected",
+			inline:   publicKeyPEM,
+			wantKeys: []string{"EC"},
+		},
+		{
+			name:     "multiple pem public keys",
+			inline:   publicKeyPEM + rsaPublicKeyPEM,
+			format:   ptr.Of(agentgateway.JWKSFormatPEM),
+			wantKeys: []string{"EC", "RSA"},
+		},
+		{
+			name:      "x509 certificate is detected",
+			inline:    certificatePEM,
+			wantKeys:  []string{"RSA"},
+			wantCerts: true,
+		},
+		{
+			name:    "invalid blob is rejected",
+			inline:  "not a key",
+			wantErr: "invalid JWK Set",
+		},
+		{
+			name:    "declared format must match content",
+			inline:  publicKeyPEM,
+			format:  ptr.Of(agentgateway.JWKSFormatX509),
+			wantErr: `unexpected PEM block 0 of type "PUBLIC KEY" for format X509`,
+		},
+		{
+			name:    "pem format without pem blocks",
+			inline:  jwkSet,
+			format:  ptr.Of(agentgateway.JWKSFormatPEM),
+			wantErr: "trailing data after PEM blocks",
+		},
+		{
+			name:    "corrupt pem block",
+			inline:  "-----BEGIN PUBLIC KEY-----\nbm90IGEga2V5\n-----END PUBLIC KEY-----\n",
+			wantErr: "PEM block 0 is not a valid public key",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := translateInlineJWKS(tc.inline, tc.format)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tc.wantSame && got != tc.inline {
+				t.Fatalf("expected inline JWKS to be unchanged, got %s", got)
+			}
+
+			var set struct {
+				Keys []struct {
+					Kty string   `json:"kty"`
+					Kid string   `json:"kid"`
+					X5c []string `json:"x5c"`
+				} `json:"keys"`
+			}
+			if err := json.Unmarshal([]byte(got), &set); err != nil {
+				t.Fatalf("output is not JSON: %v", err)
+			}
+			var parsed jose.JSONWebKeySet
+			if err := json.Unmarshal([]byte(got), &parsed); err != nil {
+				t.Fatalf("output is not a JWK Set: %v", err)
+			}
+			if len(set.Keys) != len(tc.wantKeys) {
+				t.Fatalf("expected %d keys, got %s", len(tc.wantKeys), got)
+			}
+			for i, k := range set.Keys {
+				if k.Kty != tc.wantKeys[i] {
+					t.Fatalf("expected key %d to be %s, got %s", i, tc.wantKeys[i], k.Kty)
+				}
+				if k.Kid == "" {
+					t.Fatalf("expected key %d to have a kid", i)
+				}
+				if tc.wantCerts != (len(k.X5c) > 0) {
+					t.Fatalf("unexpected x5c on key %d: %v", i, k.X5c)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,202 @@
+package plugins
+
+import (
+	"maps"
+	"strings"
+	"testing"
+
+	"istio.io/istio/pkg/kube/krt"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/agentgateway/agentgateway/controller/api/v1alpha1/agentgateway"
+)
+
+func testInlineJWTAuthentication() *agentgateway.JWTAuthentication {
+	inline := `{"keys":[]}`
+	return &agentgateway.JWTAuthentication{
+		Mode: agentgateway.JWTAuthenticationModeStrict,
+		Providers: []agentgateway.JWTProvider{{
+			Issuer: "issuer.example",
+			JWKS:   agentgateway.JWKS{Inline: &inline},
+		}},
+	}
+}
+
+func TestProcessJWTAuthenticationPolicyTokenSourcesPreserveOrder(t *testing.T) {
+	jwtAuth := testInlineJWTAuthentication()
+	jwtAuth.TokenSources = []agentgateway.AuthorizationLocation{
+		{AuthorizationLocationFields: agentgateway.AuthorizationLocationFields{
+			Cookie: &agentgateway.AuthorizationCookieLocation{Name: "session"},
+		}},
+		{AuthorizationLocationFields: agentgateway.AuthorizationLocationFields{
+			QueryParameter: &agentgateway.AuthorizationQueryParameterLocation{Name: "access_token"},
+		}},
+		{AuthorizationLocationFields: agentgateway.AuthorizationLocationFields{
+			Header: &agentgateway.AuthorizationHeaderLocation{Name: "Authorization"},
+		}},
+	}
+
+	policy, err := processJWTAuthenticationPolicy(
+		PolicyCtx{Krt: krt.TestingDummyContext{}},
+		jwtAuth,
+		nil,
+		"default/test:jwt",
+		types.NamespacedName{Namespace: "default", Name: "test"},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	sources := policy.GetTraffic().GetJwt().GetTokenSources()
+	if len(sources) != 3 {
+		t.Fatalf("expected 3 token sources, got %d", len(sources))
+	}
+	if sources[0].GetCookie().GetName() != "session" {
+		t.Fatalf("expected cookie source first, got %v", sources[0])
+	}
+	if sources[1].GetQueryParameter().GetName() != "access_token" {
+		t.Fatalf("expected query parameter source second, got %v", sources[1])
+	}
+	if sources[2].GetHeader().GetName() != "Authorization" {
+		t.Fatalf("expected header source last, got %v", sources[2])
+	}
+}
+
+func TestProcessJWTAuthenticationPolicyTokenSourcesValidation(t *testing.T) {
+	expression := agentgateway.CELExpression(`request.headers["x-token"]`)
+	for _, tc := range []struct {
+		name    string
+		mutate  func(*agentgateway.JWTAuthentication)
+		wantErr string
+	}{
+		{
+			name: "empty cookie name",
+			mutate: func(j *agentgateway.JWTAuthentication) {
+				j.TokenSources = []agentgateway.AuthorizationLocation{{AuthorizationLocationFields: agentgateway.AuthorizationLocationFields{
+					Cookie: &agentgateway.AuthorizationCookieLocation{},
+				}}}
+			},
+			wantErr: "jwtAuthentication tokenSources[0] cookie name must not be empty",
+		},
+		{
+			name: "no location kind",
+			mutate: func(j *agentgateway.JWTAuthentication) {
+				j.TokenSources = []agentgateway.AuthorizationLocation{{}}
+			},
+			wantErr: "jwtAuthentication tokenSources[0] must set exactly one of header, queryParameter, or cookie",
+		},
+		{
+			name: "combined with location",
+			mutate: func(j *agentgateway.JWTAuthentication) {
+				j.Location = &agentgateway.AuthorizationExtractionLocation{Expression: &expression}
+				j.TokenSources = []agentgateway.AuthorizationLocation{{AuthorizationLocationFields: agentgateway.AuthorizationLocationFields{
+					QueryParameter: &agentgateway.AuthorizationQueryParameterLocation{Name: "access_token"},
+				}}}
+			},
+			wantErr: "jwtAuthentication location and tokenSources may not both be set",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			jwtAuth := testInlineJWTAuthentication()
+			tc.mutate(jwtAuth)
+
+			_, err := processJWTAuthenticationPolicy(
+				PolicyCtx{Krt: krt.TestingDummyContext{}},
+				jwtAuth,
+				nil,
+				"default/test:jwt",
+				types.NamespacedName{Namespace: "default", Name: "test"},
+			)
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestProcessJWTAuthenticationPolicyForwardToken(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		forward bool
+	}{
+		{name: "strip by default", forward: false},
+		{name: "forward", forward: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			jwtAuth := testInlineJWTAuthentication()
+			jwtAuth.ForwardToken = tc.forward
+			jwtAuth.TokenSources = []agentgateway.AuthorizationLocation{{AuthorizationLocationFields: agentgateway.AuthorizationLocationFields{
+				Cookie: &agentgateway.AuthorizationCookieLocation{Name: "session"},
+			}}}
+
+			policy, err := processJWTAuthenticationPolicy(
+				PolicyCtx{Krt: krt.TestingDummyContext{}},
+				jwtAuth,
+				nil,
+				"default/test:jwt",
+				types.NamespacedName{Namespace: "default", Name: "test"},
+			)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			jwt := policy.GetTraffic().GetJwt()
+			if jwt.GetForwardToken() != tc.forward {
+				t.Fatalf("expected forwardToken=%v, got %v", tc.forward, jwt.GetForwardToken())
+			}
+			// Stripping only applies to the configured source; the Authorization header
+			// must not be implied when the token is read from a cookie.
+			if jwt.GetAuthorizationLocation() != nil {
+				t.Fatalf("expected no authorization location, got %v", jwt.GetAuthorizationLocation())
+			}
+			if sources := jwt.GetTokenSources(); len(sources) != 1 || sources[0].GetCookie().GetName() != "session" {
+				t.Fatalf("expected only the session cookie token source, got %v", sources)
+			}
+		})
+	}
+}
+
+func TestProcessJWTAuthenticationPolicyRequiredClaims(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		claims  map[string]string
+		wantErr string
+	}{
+		{name: "presence only", claims: map[string]string{"azp": ""}},
+		{name: "value match", claims: map[string]string{"role": "admin", "azp": ""}},
+		{name: "empty claim name", claims: map[string]string{"": "admin"}, wantErr: "jwtAuthentication providers[0] requiredClaims keys must not be empty"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			jwtAuth := testInlineJWTAuthentication()
+			jwtAuth.Providers[0].RequiredClaims = tc.claims
+
+			policy, err := processJWTAuthenticationPolicy(
+				PolicyCtx{Krt: krt.TestingDummyContext{}},
+				jwtAuth,
+				nil,
+				"default/test:jwt",
+				types.NamespacedName{Namespace: "default", Name: "test"},
+			)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+				}
+				if providers := policy.GetTraffic().GetJwt().GetProviders(); len(providers) != 0 {
+					t.Fatalf("expected invalid provider to be omitted, got %v", providers)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			providers := policy.GetTraffic().GetJwt().GetProviders()
+			if len(providers) != 1 {
+				t.Fatalf("expected 1 provider, got %d", len(providers))
+			}
+			if got := providers[0].GetRequiredClaims(); !maps.Equal(got, tc.claims) {
+				t.Fatalf("expected required claims %v, got %v", tc.claims, got)
+			}
+		})
+	}
+}
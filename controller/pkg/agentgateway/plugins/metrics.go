@@ -0,0 +1,117 @@
+package plugins
+
+import (
+	"time"
+
+	"istio.io/istio/pkg/kube/krt"
+	"istio.io/istio/pkg/util/sets"
+
+	"github.com/agentgateway/agentgateway/controller/pkg/metrics"
+	"github.com/agentgateway/agentgateway/controller/pkg/wellknown"
+)
+
+const (
+	policySubsystem = "policy"
+	kindLabel       = "kind"
+	reasonLabel     = "reason"
+)
+
+var (
+	policyTranslationHistogramBuckets = []float64{0.0001, 0.0005, 0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1}
+	policyTranslationDuration         = metrics.NewHistogram(
+		metrics.HistogramOpts{
+			Subsystem:                       policySubsystem,
+			Name:                            "translation_duration_seconds",
+			Help:                            "Duration of translating a single policy resource",
+			Buckets:                         policyTranslationHistogramBuckets,
+			NativeHistogramBucketFactor:     1.1,
+			NativeHistogramMaxBucketNumber:  100,
+			NativeHistogramMinResetDuration: time.Hour,
+		},
+		[]string{kindLabel},
+	)
+	policiesRejectedTotal = metrics.NewCounter(
+		metrics.CounterOpts{
+			Subsystem: policySubsystem,
+			Name:      "rejected_total",
+			Help:      "Total number of policy translations that were fully or partially rejected",
+		},
+		[]string{kindLabel, reasonLabel},
+	)
+	policiesEmitted = metrics.NewGauge(
+		metrics.GaugeOpts{
+			Subsystem: policySubsystem,
+			Name:      "emitted",
+			Help:      "Number of policies currently emitted by the policy translation of each kind",
+		},
+		[]string{kindLabel},
+	)
+	policyLastTranslationTimestamp = metrics.NewGauge(
+		metrics.GaugeOpts{
+			Subsystem: policySubsystem,
+			Name:      "last_translation_timestamp_seconds",
+			Help:      "Unix timestamp of the last successful policy translation",
+		},
+		[]string{kindLabel},
+	)
+	policyLastTranslationDuration = metrics.NewGauge(
+		metrics.GaugeOpts{
+			Subsystem: policySubsystem,
+			Name:      "last_translation_duration_seconds",
+			Help:      "Duration of the last successful policy translation",
+		},
+		[]string{kindLabel},
+	)
+)
+
+// metricPolicyKinds bounds the kind label of the per-kind policy gauges.
+var metricPolicyKinds = sets.New(
+	wellknown.AgentgatewayPolicyGVK.Kind,
+	wellknown.InferencePoolGVK.Kind,
+)
+
+// collectPolicyTranslationMetrics is called at the start of a policy translation and returns a
+// function called at the end with the reason of the Accepted condition, if the policy was rejected.
+func collectPolicyTranslationMetrics(kind string) func(rejectedReason string) {
+	if !metrics.Active() {
+		return func(string) {}
+	}
+
+	start := time.Now()
+
+	return func(rejectedReason string) {
+		end := time.Now()
+		duration := end.Sub(start).Seconds()
+		policyTranslationDuration.Observe(duration,
+			metrics.Label{Name: kindLabel, Value: kind},
+		)
+		if rejectedReason != "" {
+			policiesRejectedTotal.Inc(
+				metrics.Label{Name: kindLabel, Value: kind},
+				metrics.Label{Name: reasonLabel, Value: rejectedReason},
+			)
+			return
+		}
+		if metricPolicyKinds.Contains(kind) {
+			policyLastTranslationTimestamp.Set(float64(end.UnixNano())/float64(time.Second),
+				metrics.Label{Name: kindLabel, Value: kind},
+			)
+			policyLastTranslationDuration.Set(duration,
+				metrics.Label{Name: kindLabel, Value: kind},
+			)
+		}
+	}
+}
+
+// trackEmittedPolicies keeps the emitted policies gauge for kind in sync with the size of col.
+// Kinds outside metricPolicyKinds are ignored to keep the label cardinality bounded.
+func trackEmittedPolicies(kind string, col krt.Collection[AgwPolicy]) {
+	if !metrics.Active() || !metricPolicyKinds.Contains(kind) {
+		return
+	}
+	col.RegisterBatch(func([]krt.Event[AgwPolicy]) {
+		policiesEmitted.Set(float64(len(col.List())),
+			metrics.Label{Name: kindLabel, Value: kind},
+		)
+	}, true)
+}
@@ -0,0 +1,145 @@
+package plugins
+
+import (
+	"testing"
+	"time"
+
+	"istio.io/istio/pkg/kube/krt"
+	"istio.io/istio/pkg/test"
+	"istio.io/istio/pkg/test/util/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/agentgateway/agentgateway/api"
+
+	"github.com/agentgateway/agentgateway/controller/api/v1alpha1/agentgateway"
+	"github.com/agentgateway/agentgateway/controller/pkg/metrics"
+	"github.com/agentgateway/agentgateway/controller/pkg/metrics/metricstest"
+	"github.com/agentgateway/agentgateway/controller/pkg/pluginsdk/krtutil"
+	"github.com/agentgateway/agentgateway/controller/pkg/wellknown"
+)
+
+func TestPolicyTranslationMetricsOnRejectedPolicy(t *testing.T) {
+	policiesRejectedTotal.Reset()
+	policyTranslationDuration.Reset()
+
+	policy := &agentgateway.AgentgatewayPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "invalid-cel", Namespace: "default"},
+		Spec: agentgateway.AgentgatewayPolicySpec{
+			Traffic: &agentgateway.Traffic{
+				Authorization: &agentgateway.Authorization{
+					Policy: agentgateway.AuthorizationPolicy{
+						MatchExpressions: []agentgateway.CELExpression{"foolen_{{request.path}}"},
+					},
+				},
+			},
+		},
+	}
+
+	TranslateAgentgatewayPolicy(
+		krt.TestingDummyContext{},
+		policy,
+		&AgwCollections{ControllerName: wellknown.DefaultAgwControllerName},
+		BuildReferenceIndex(nil, nil, ReferenceTypes{}),
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+
+	gathered := metricstest.MustGatherMetrics(t)
+	gathered.AssertMetrics("agentgateway_policy_rejected_total", []metricstest.ExpectMetric{
+		// Reset initializes an empty-labelled series.
+		&metricstest.ExpectedMetric{
+			Labels: []metrics.Label{{Name: kindLabel, Value: ""}, {Name: reasonLabel, Value: ""}},
+			Value:  0,
+		},
+		&metricstest.ExpectedMetric{
+			Labels: []metrics.Label{
+				{Name: kindLabel, Value: wellknown.AgentgatewayPolicyGVK.Kind},
+				{Name: reasonLabel, Value: string(agentgateway.PolicyReasonPartiallyValid)},
+			},
+			Value: 1,
+		},
+	})
+	gathered.AssertHistogramPopulated("agentgateway_policy_translation_duration_seconds")
+}
+
+func TestPolicyTranslationMetricsOnSuccessfulPolicy(t *testing.T) {
+	policyLastTranslationTimestamp.Reset()
+	policyLastTranslationDuration.Reset()
+
+	policy := &agentgateway.AgentgatewayPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "valid", Namespace: "default"},
+		Spec: agentgateway.AgentgatewayPolicySpec{
+			Traffic: &agentgateway.Traffic{
+				Authorization: &agentgateway.Authorization{
+					Policy: agentgateway.AuthorizationPolicy{
+						MatchExpressions: []agentgateway.CELExpression{"request.path == '/'"},
+					},
+				},
+			},
+		},
+	}
+
+	before := float64(time.Now().Unix())
+	TranslateAgentgatewayPolicy(
+		krt.TestingDummyContext{},
+		policy,
+		&AgwCollections{ControllerName: wellknown.DefaultAgwControllerName},
+		BuildReferenceIndex(nil, nil, ReferenceTypes{}),
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+
+	kind := []metrics.Label{{Name: kindLabel, Value: wellknown.AgentgatewayPolicyGVK.Kind}}
+	gathered := metricstest.MustGatherMetrics(t)
+	gathered.AssertMetricsInclude("agentgateway_policy_last_translation_timestamp_seconds", []metricstest.ExpectMetric{
+		&metricstest.ExpectedMetricValueTest{Labels: kind, Test: metricstest.GreaterOrEqual(before)},
+	})
+	gathered.AssertMetricsInclude("agentgateway_policy_last_translation_duration_seconds", []metricstest.ExpectMetric{
+		&metricstest.ExpectedMetricValueTest{Labels: kind, Test: metricstest.GreaterOrEqual(0)},
+	})
+}
+
+func TestEmittedPoliciesGauge(t *testing.T) {
+	policiesEmitted.Reset()
+
+	opts := krtutil.NewKrtOptions(test.NewStop(t), nil)
+	gw := types.NamespacedName{Namespace: "default", Name: "gw"}
+	policy := func(key string) AgwPolicy {
+		return AgwPolicy{Gateway: &gw, Policy: &api.Policy{Key: key}}
+	}
+	col := krt.NewStaticCollection(nil, []AgwPolicy{policy("a")}, opts.ToOptions("test/Policies")...)
+	trackEmittedPolicies(wellknown.InferencePoolGVK.Kind, col)
+	// Unknown kinds are not tracked.
+	trackEmittedPolicies("Unknown", col)
+
+	labels := []metrics.Label{{Name: kindLabel, Value: wellknown.InferencePoolGVK.Kind}}
+	emitted := func() float64 {
+		value := -1.0
+		gathered := metricstest.MustGatherMetrics(t)
+		if gathered.MetricLength("agentgateway_policy_emitted") == 0 {
+			return value
+		}
+		gathered.AssertMetricsInclude("agentgateway_policy_emitted", []metricstest.ExpectMetric{
+			&metricstest.ExpectedMetricValueTest{Labels: labels, Test: func(v float64) bool {
+				value = v
+				return true
+			}},
+		})
+		return value
+	}
+	assert.EventuallyEqual(t, emitted, 1.0)
+
+	col.UpdateObject(policy("b"))
+	assert.EventuallyEqual(t, emitted, 2.0)
+
+	col.DeleteObject(policy("a").ResourceName())
+	assert.EventuallyEqual(t, emitted, 1.0)
+
+	// The unknown kind is not tracked.
+	metricstest.MustGatherMetrics(t).AssertMetricsLabels("agentgateway_policy_emitted", [][]metrics.Label{labels})
+}
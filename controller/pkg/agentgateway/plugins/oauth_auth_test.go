@@ -328,7 +328,7 @@ func TestOAuthTokenExchangeClientAuthMissingSecretKeyPreservesExplicitSecretInte
 		BackendRef: oauthTokenEndpointRef(),
 		ClientAuth: &agentgateway.OAuthClientAuth{
 			ClientID: "gateway",
-			SecretRef: &agentgateway.LocalSecretKeyRef{
+			SecretRef: &agentgateway.SecretKeyRef{
 				Name: "oauth-client",
 			},
 		},
@@ -578,7 +578,7 @@ func TestOAuthTokenExchangeEnumDefaulting(t *testing.T) {
 		BackendRef: oauthTokenEndpointRef(),
 		ClientAuth: &agentgateway.OAuthClientAuth{
 			ClientID: "gateway",
-			SecretRef: &agentgateway.LocalSecretKeyRef{
+			SecretRef: &agentgateway.SecretKeyRef{
 				Name: "oauth-client",
 			},
 		},
@@ -0,0 +1,141 @@
+package plugins
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"istio.io/istio/pkg/slices"
+	"istio.io/istio/pkg/util/sets"
+
+	"github.com/agentgateway/agentgateway/controller/api/v1alpha1/agentgateway"
+)
+
+// PolicyErrorCategory is a stable, machine-readable classification of a policy translation error.
+// A rejected policy whose errors all share one category reports that category as its condition
+// reason, and every category is appended to the condition message so tooling can react without
+// matching on the human message.
+type PolicyErrorCategory string
+
+const (
+	// PolicyErrorCategoryReferenceNotFound indicates a referenced resource does not exist.
+	PolicyErrorCategoryReferenceNotFound PolicyErrorCategory = "ReferenceNotFound"
+	// PolicyErrorCategoryReferenceNotPermitted indicates a cross-namespace reference is not
+	// permitted by a ReferenceGrant.
+	PolicyErrorCategoryReferenceNotPermitted PolicyErrorCategory = "ReferenceNotPermitted"
+	// PolicyErrorCategoryInvalidValue indicates a field has an unsupported value.
+	PolicyErrorCategoryInvalidValue PolicyErrorCategory = "InvalidValue"
+	// PolicyErrorCategoryInvalidCombination indicates fields are set that cannot be used together.
+	PolicyErrorCategoryInvalidCombination PolicyErrorCategory = "InvalidCombination"
+)
+
+// ConditionReason returns the Accepted condition reason reported for a policy rejected with
+// errors of this category.
+func (c PolicyErrorCategory) ConditionReason() agentgateway.PolicyConditionReason {
+	switch c {
+	case PolicyErrorCategoryReferenceNotFound:
+		return agentgateway.PolicyReasonReferenceNotFound
+	case PolicyErrorCategoryReferenceNotPermitted:
+		return agentgateway.PolicyReasonReferenceNotPermitted
+	case PolicyErrorCategoryInvalidValue:
+		return agentgateway.PolicyReasonInvalidValue
+	case PolicyErrorCategoryInvalidCombination:
+		return agentgateway.PolicyReasonInvalidCombination
+	default:
+		return agentgateway.PolicyReasonInvalid
+	}
+}
+
+// CategorizedError attaches a PolicyErrorCategory to an error without changing its message.
+type CategorizedError struct {
+	Category PolicyErrorCategory
+	Err      error
+}
+
+func (e *CategorizedError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *CategorizedError) Unwrap() error {
+	return e.Err
+}
+
+// categorizedErrorf creates an error with the given category and formatted message.
+func categorizedErrorf(category PolicyErrorCategory, format string, args ...any) error {
+	return &CategorizedError{Category: category, Err: fmt.Errorf(format, args...)}
+}
+
+// PolicyErrorCategoryOf returns the category shared by every error within err, including those
+// combined with errors.Join. It returns false if any error is uncategorized or the categories differ.
+func PolicyErrorCategoryOf(err error) (PolicyErrorCategory, bool) {
+	if err == nil {
+		return "", false
+	}
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		var category PolicyErrorCategory
+		for _, e := range joined.Unwrap() {
+			c, ok := PolicyErrorCategoryOf(e)
+			if !ok || (category != "" && c != category) {
+				return "", false
+			}
+			category = c
+		}
+		return category, category != ""
+	}
+	category := leafPolicyErrorCategory(err)
+	return category, category != ""
+}
+
+func leafPolicyErrorCategory(err error) PolicyErrorCategory {
+	if ce, ok := errors.AsType[*CategorizedError](err); ok {
+		return ce.Category
+	}
+	if be, ok := errors.AsType[*BackendReferenceError](err); ok {
+		if be.Reason == BackendReferenceErrorReasonBackendNotFound {
+			return PolicyErrorCategoryReferenceNotFound
+		}
+		return PolicyErrorCategoryInvalidValue
+	}
+	return ""
+}
+
+// policyErrorReason returns the Accepted condition reason for a policy rejected with err.
+func policyErrorReason(err error) agentgateway.PolicyConditionReason {
+	category, _ := PolicyErrorCategoryOf(err)
+	return category.ConditionReason()
+}
+
+// PolicyErrorCategories returns the sorted, unique categories of all errors within err, including
+// those combined with errors.Join. Uncategorized errors contribute nothing.
+func PolicyErrorCategories(err error) []PolicyErrorCategory {
+	categories := sets.New[PolicyErrorCategory]()
+	collectPolicyErrorCategories(err, categories)
+	return slices.Sort(categories.UnsortedList())
+}
+
+func collectPolicyErrorCategories(err error, categories sets.Set[PolicyErrorCategory]) {
+	if err == nil {
+		return
+	}
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		for _, e := range joined.Unwrap() {
+			collectPolicyErrorCategories(e, categories)
+		}
+		return
+	}
+	if category := leafPolicyErrorCategory(err); category != "" {
+		categories.Insert(category)
+	}
+}
+
+// policyErrorMessage formats err for a condition message, appending any PolicyErrorCategories as
+// `[codes: A,B]` so the human-readable message is preserved.
+func policyErrorMessage(err error) string {
+	categories := PolicyErrorCategories(err)
+	if len(categories) == 0 {
+		return err.Error()
+	}
+	return fmt.Sprintf("%s [codes: %s]", err.Error(), strings.Join(slices.Map(categories, func(c PolicyErrorCategory) string {
+		return string(c)
+	}), ","))
+}
@@ -0,0 +1,177 @@
+package plugins
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"istio.io/istio/pkg/kube/krt"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	inf "sigs.k8s.io/gateway-api-inference-extension/api/v1"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/agentgateway/agentgateway/controller/api/annotations"
+	apisettings "github.com/agentgateway/agentgateway/controller/api/settings"
+	"github.com/agentgateway/agentgateway/controller/api/v1alpha1/agentgateway"
+)
+
+func TestPolicyErrorCategories(t *testing.T) {
+	authErr := categorizedErrorf(PolicyErrorCategoryInvalidCombination, "invalid auth mode combination: %s", "jwt and mcp")
+	err := errors.Join(
+		errors.New("plain error"),
+		fmt.Errorf("wrapped: %w", authErr),
+		errors.Join(authErr, &BackendReferenceError{Reason: BackendReferenceErrorReasonBackendNotFound, Message: "missing"}),
+	)
+
+	got := PolicyErrorCategories(err)
+	want := []PolicyErrorCategory{PolicyErrorCategoryInvalidCombination, PolicyErrorCategoryReferenceNotFound}
+	if !slices.Equal(got, want) {
+		t.Fatalf("PolicyErrorCategories() = %v, want %v", got, want)
+	}
+	if categories := PolicyErrorCategories(errors.New("plain error")); len(categories) != 0 {
+		t.Fatalf("expected no categories for a plain error, got %v", categories)
+	}
+}
+
+func TestPolicyErrorMessage(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		err  error
+		want string
+	}{
+		{
+			name: "plain",
+			err:  errors.New("bad policy"),
+			want: "bad policy",
+		},
+		{
+			name: "categorized",
+			err:  categorizedErrorf(PolicyErrorCategoryInvalidCombination, "invalid auth mode combination"),
+			want: "invalid auth mode combination [codes: InvalidCombination]",
+		},
+		{
+			name: "joined",
+			err:  errors.Join(errors.New("bad policy"), categorizedErrorf(PolicyErrorCategoryInvalidCombination, "invalid auth mode combination")),
+			want: "bad policy\ninvalid auth mode combination [codes: InvalidCombination]",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := policyErrorMessage(tc.err); got != tc.want {
+				t.Fatalf("policyErrorMessage() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPolicyConditionReasonForCategory(t *testing.T) {
+	namespace := gwv1.Namespace("backend-ns")
+	grantErr := func() error {
+		_, err := BuildBackendRef(PolicyCtx{
+			Krt: krt.TestingDummyContext{},
+			Collections: &AgwCollections{
+				Settings: apisettings.Settings{
+					BackendRefGrantMode: apisettings.BackendRefGrantModeRouteAndPolicy,
+				},
+			},
+			Grants: &recordingGrantChecker{},
+		}, gwv1.BackendObjectReference{Name: "backend", Namespace: &namespace}, "policy-ns")
+		return err
+	}
+	notFound := &BackendReferenceError{Reason: BackendReferenceErrorReasonBackendNotFound, Message: "unable to find the Service ns/svc"}
+	invalidCEL := categorizedErrorf(PolicyErrorCategoryInvalidValue, "authorization matchExpression is not a valid CEL expression: %s", "bad(")
+
+	for _, tc := range []struct {
+		name string
+		err  error
+		want agentgateway.PolicyConditionReason
+	}{
+		{
+			name: "backend not found",
+			err:  fmt.Errorf("failed to build extAuth: %w", notFound),
+			want: agentgateway.PolicyReasonReferenceNotFound,
+		},
+		{
+			name: "unsupported backend kind",
+			err:  &BackendReferenceError{Reason: BackendReferenceErrorReasonInvalidKind, Message: "unsupported backend Foo"},
+			want: agentgateway.PolicyReasonInvalidValue,
+		},
+		{
+			name: "missing reference grant",
+			err:  grantErr(),
+			want: agentgateway.PolicyReasonReferenceNotPermitted,
+		},
+		{
+			name: "invalid CEL expression",
+			err:  invalidCEL,
+			want: agentgateway.PolicyReasonInvalidValue,
+		},
+		{
+			name: "invalid auth combination",
+			err:  categorizedErrorf(PolicyErrorCategoryInvalidCombination, "invalid auth mode combination"),
+			want: agentgateway.PolicyReasonInvalidCombination,
+		},
+		{
+			name: "invalid inference pool annotation",
+			err: func() error {
+				_, err := inferencePoolEndpointPickerSNI(&inf.InferencePool{ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{annotations.EndpointPickerSNI: "not a dns name"},
+				}})
+				return err
+			}(),
+			want: agentgateway.PolicyReasonInvalidValue,
+		},
+		{
+			name: "invalid https redirect status",
+			err: func() error {
+				_, err := processHTTPSRedirectPolicy(&agentgateway.HTTPSRedirect{StatusCode: new(int32(200))}, "redirect", types.NamespacedName{})
+				return err
+			}(),
+			want: agentgateway.PolicyReasonInvalidValue,
+		},
+		{
+			name: "metadata headers with transformation",
+			err: func() error {
+				_, err := processMetadataHeadersPolicy(&agentgateway.Traffic{
+					Transformation:  &agentgateway.TransformationOrConditional{},
+					MetadataHeaders: []agentgateway.MetadataHeader{{Name: "x-tenant"}},
+				}, "metadata", types.NamespacedName{})
+				return err
+			}(),
+			want: agentgateway.PolicyReasonInvalidCombination,
+		},
+		{
+			name: "joined errors of one category",
+			err:  errors.Join(notFound, fmt.Errorf("failed to build global rate limit: %w", notFound)),
+			want: agentgateway.PolicyReasonReferenceNotFound,
+		},
+		{
+			name: "joined errors of mixed categories",
+			err:  errors.Join(notFound, invalidCEL),
+			want: agentgateway.PolicyReasonInvalid,
+		},
+		{
+			name: "uncategorized error",
+			err:  errors.Join(invalidCEL, errors.New("bad policy")),
+			want: agentgateway.PolicyReasonInvalid,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.err == nil {
+				t.Fatal("expected an error")
+			}
+			accepted := PolicyConditionMap(tc.err, false)[string(agentgateway.PolicyConditionAccepted)]
+			if accepted.Status != metav1.ConditionFalse || accepted.Reason != string(tc.want) {
+				t.Fatalf("Accepted = %s/%s, want False/%s", accepted.Status, accepted.Reason, tc.want)
+			}
+			if accepted.Message != policyErrorMessage(tc.err) {
+				t.Fatalf("message = %q, want %q", accepted.Message, policyErrorMessage(tc.err))
+			}
+			partial := PolicyConditionMap(tc.err, true)[string(agentgateway.PolicyConditionAccepted)]
+			if partial.Reason != string(agentgateway.PolicyReasonPartiallyValid) {
+				t.Fatalf("partially translated policy reason = %s, want %s", partial.Reason, agentgateway.PolicyReasonPartiallyValid)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package plugins
+
+import (
+	"fmt"
+	"strings"
+
+	"istio.io/istio/pkg/kube/controllers"
+	"istio.io/istio/pkg/kube/krt"
+	"istio.io/istio/pkg/maps"
+	"istio.io/istio/pkg/ptr"
+	"istio.io/istio/pkg/slices"
+	"istio.io/istio/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/agentgateway/agentgateway/controller/pkg/pluginsdk/krtutil"
+	"github.com/agentgateway/agentgateway/controller/pkg/wellknown"
+)
+
+// policyKeyEscaper percent-encodes the separators used within policy keys. Kubernetes names
+// cannot contain them, so keys derived from valid objects are unchanged.
+var policyKeyEscaper = strings.NewReplacer("%", "%25", "/", "%2F", ":", "%3A")
+
+// policyKeyComponent escapes a single component of a policy key so it cannot be mistaken for
+// a separator.
+func policyKeyComponent(s string) string {
+	return policyKeyEscaper.Replace(s)
+}
+
+// policyKey derives the `namespace/name` portion of a policy key for an object.
+func policyKey(namespace, name string) string {
+	return policyKeyComponent(namespace) + "/" + policyKeyComponent(name)
+}
+
+// PolicyKeyCollisions detects policies whose key is produced for the same Gateway by more than one
+// object. Colliding policies are dropped rather than letting one of them win arbitrarily, and the
+// returned status collections report the collision on every object involved. Objects without a
+// PolicyStatus cannot report it, so for those the collision is only logged.
+func PolicyKeyCollisions(
+	policies krt.Collection[AgwPolicy],
+	statuses map[schema.GroupKind]krt.StatusCollection[controllers.Object, any],
+	krtopts krtutil.KrtOptions,
+) (krt.Collection[AgwPolicy], map[schema.GroupKind]krt.StatusCollection[controllers.Object, any]) {
+	byKey := krt.NewIndex(policies, "policyKey", func(p AgwPolicy) []string {
+		return []string{p.gatewayKey()}
+	})
+	bySource := krt.NewIndex(policies, "policySource", func(p AgwPolicy) []string {
+		return []string{p.source()}
+	})
+	// collidingSources returns the other objects that produce the key of p for its Gateway.
+	collidingSources := func(ctx krt.HandlerContext, p AgwPolicy) []string {
+		others := sets.New[string]()
+		for _, other := range byKey.Fetch(ctx, p.gatewayKey()) {
+			if other.source() != p.source() {
+				others.Insert(other.source())
+			}
+		}
+		return sets.SortedList(others)
+	}
+
+	resolved := krt.NewCollection(policies, func(ctx krt.HandlerContext, p AgwPolicy) *AgwPolicy {
+		if others := collidingSources(ctx, p); len(others) > 0 {
+			logger.Error("dropping policy with colliding key",
+				"key", p.Policy.Key,
+				"gateway", p.Gateway,
+				"source", p.source(),
+				"colliding", others)
+			return nil
+		}
+		return &p
+	}, krtopts.ToOptions("policies/KeysResolved")...)
+
+	out := make(map[schema.GroupKind]krt.StatusCollection[controllers.Object, any], len(statuses))
+	for gk, col := range statuses {
+		out[gk] = krt.NewCollection(col, func(ctx krt.HandlerContext, o krt.ObjectWithStatus[controllers.Object, any]) *krt.ObjectWithStatus[controllers.Object, any] {
+			source := gk.Kind + "/" + o.Obj.GetNamespace() + "/" + o.Obj.GetName()
+			collisions := map[types.NamespacedName][]string{}
+			for _, p := range bySource.Fetch(ctx, source) {
+				if others := collidingSources(ctx, p); len(others) > 0 {
+					collisions[*p.Gateway] = append(collisions[*p.Gateway],
+						fmt.Sprintf("policy key %s is also produced by %s", p.Policy.Key, strings.Join(others, ", ")))
+				}
+			}
+			if len(collisions) == 0 {
+				return &o
+			}
+			status, ok := o.Status.(*gwv1.PolicyStatus)
+			if !ok || status == nil {
+				logger.Warn("cannot report policy key collision on status", "kind", gk.Kind, "object", source)
+				return &o
+			}
+			return &krt.ObjectWithStatus[controllers.Object, any]{
+				Obj:    o.Obj,
+				Status: policyKeyCollisionStatus(status, o.Obj.GetNamespace(), o.Obj.GetGeneration(), collisions),
+			}
+		}, krtopts.ToOptions("policies/"+gk.Kind+"/KeyCollisions")...)
+	}
+	return resolved, out
+}
+
+// policyKeyCollisionStatus marks the ancestors for Gateways with colliding policy keys as conflicted.
+// If none of the ancestors is one of those Gateways, every ancestor is marked.
+func policyKeyCollisionStatus(
+	status *gwv1.PolicyStatus,
+	namespace string,
+	generation int64,
+	collisions map[types.NamespacedName][]string,
+) *gwv1.PolicyStatus {
+	out := status.DeepCopy()
+	conflicted := func(ancestor *gwv1.PolicyAncestorStatus, messages []string) {
+		slices.Sort(messages)
+		conds := programmedConditionMap(conflictedConditionMap(map[string]*Condition{}, strings.Join(messages, "; ")))
+		ancestor.Conditions = setConditions(generation, ancestor.Conditions, conds)
+	}
+	marked := false
+	for i := range out.Ancestors {
+		ref := out.Ancestors[i].AncestorRef
+		if string(ptr.OrDefault(ref.Kind, wellknown.GatewayKind)) != wellknown.GatewayKind {
+			continue
+		}
+		gateway := types.NamespacedName{Namespace: string(ptr.OrDefault(ref.Namespace, gwv1.Namespace(namespace))), Name: string(ref.Name)}
+		if messages, ok := collisions[gateway]; ok {
+			conflicted(&out.Ancestors[i], slices.Clone(messages))
+			marked = true
+		}
+	}
+	if !marked {
+		var all []string
+		for _, gateway := range slices.SortBy(maps.Keys(collisions), types.NamespacedName.String) {
+			all = append(all, collisions[gateway]...)
+		}
+		for i := range out.Ancestors {
+			conflicted(&out.Ancestors[i], slices.Clone(all))
+		}
+	}
+	return out
+}
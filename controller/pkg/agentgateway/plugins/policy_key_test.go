@@ -0,0 +1,118 @@
+package plugins
+
+import (
+	"testing"
+
+	"istio.io/istio/pkg/kube/controllers"
+	"istio.io/istio/pkg/kube/krt"
+	"istio.io/istio/pkg/slices"
+	"istio.io/istio/pkg/test"
+	"istio.io/istio/pkg/test/util/assert"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/agentgateway/agentgateway/api"
+	"github.com/agentgateway/agentgateway/controller/api/v1alpha1/agentgateway"
+	"github.com/agentgateway/agentgateway/controller/pkg/pluginsdk/krtutil"
+	"github.com/agentgateway/agentgateway/controller/pkg/wellknown"
+)
+
+func TestPolicyKeyEscaping(t *testing.T) {
+	assert.Equal(t, policyKey("default", "my-policy"), "default/my-policy")
+	assert.Equal(t, policyKey("ns", "a:b"), "ns/a%3Ab")
+	assert.Equal(t, policyKeyComponent("50%/x"), "50%25%2Fx")
+
+	// Components containing separators must not be able to produce another object's key.
+	if policyKey("ns", "a:b") == policyKey("ns:a", "b") {
+		t.Fatalf("expected distinct keys, got %q", policyKey("ns", "a:b"))
+	}
+	if policyKey("ns", "a/b") == policyKey("ns/a", "b") {
+		t.Fatalf("expected distinct keys, got %q", policyKey("ns", "a/b"))
+	}
+}
+
+func TestPolicyKeyCollisions(t *testing.T) {
+	opts := krtutil.NewKrtOptions(test.NewStop(t), nil)
+	gw := types.NamespacedName{Namespace: "default", Name: "gw"}
+	policy := func(name, key string) AgwPolicy {
+		return AgwPolicy{Gateway: &gw, Policy: &api.Policy{
+			Key:  key,
+			Name: TypedResourceFromName(wellknown.AgentgatewayPolicyGVK.Kind, types.NamespacedName{Namespace: "default", Name: name}),
+		}}
+	}
+	policies := krt.NewStaticCollection(nil, []AgwPolicy{
+		policy("a", "shared"),
+		policy("b", "shared"),
+		policy("c", "unique"),
+	}, opts.ToOptions("test/Policies")...)
+
+	withStatus := func(name string) krt.ObjectWithStatus[controllers.Object, any] {
+		return krt.ObjectWithStatus[controllers.Object, any]{
+			Obj: &agentgateway.AgentgatewayPolicy{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Generation: 2}},
+			Status: &gwv1.PolicyStatus{Ancestors: []gwv1.PolicyAncestorStatus{{
+				AncestorRef: gwv1.ParentReference{
+					Group:     new(gwv1.Group(wellknown.GatewayGVK.Group)),
+					Kind:      new(gwv1.Kind(wellknown.GatewayKind)),
+					Name:      gwv1.ObjectName(gw.Name),
+					Namespace: new(gwv1.Namespace(gw.Namespace)),
+				},
+				ControllerName: wellknown.DefaultAgwControllerName,
+				Conditions: []metav1.Condition{{
+					Type:   string(agentgateway.PolicyConditionAccepted),
+					Status: metav1.ConditionTrue,
+					Reason: string(agentgateway.PolicyReasonValid),
+				}},
+			}}},
+		}
+	}
+	statuses := krt.NewStaticCollection(nil, []krt.ObjectWithStatus[controllers.Object, any]{
+		withStatus("a"),
+		withStatus("b"),
+		withStatus("c"),
+	}, opts.ToOptions("test/Statuses")...)
+
+	gk := wellknown.AgentgatewayPolicyGVK.GroupKind()
+	resolved, resolvedStatuses := PolicyKeyCollisions(policies, map[schema.GroupKind]krt.StatusCollection[controllers.Object, any]{gk: statuses}, opts)
+
+	// Neither colliding policy wins; only the policy with a unique key is kept.
+	keys := func() []string {
+		return slices.Sort(slices.Map(resolved.List(), func(p AgwPolicy) string { return p.Policy.Key }))
+	}
+	assert.EventuallyEqual(t, keys, []string{"unique"})
+
+	accepted := func(name string) func() *metav1.Condition {
+		return func() *metav1.Condition {
+			o := resolvedStatuses[gk].GetKey("default/" + name)
+			if o == nil {
+				return nil
+			}
+			cond := meta.FindStatusCondition(o.Status.(*gwv1.PolicyStatus).Ancestors[0].Conditions, string(agentgateway.PolicyConditionAccepted))
+			return &metav1.Condition{Status: cond.Status, Reason: cond.Reason, Message: cond.Message}
+		}
+	}
+	assert.EventuallyEqual(t, accepted("a"), &metav1.Condition{
+		Status:  metav1.ConditionFalse,
+		Reason:  string(gwv1.PolicyReasonConflicted),
+		Message: "policy key shared is also produced by AgentgatewayPolicy/default/b",
+	})
+	assert.EventuallyEqual(t, accepted("b"), &metav1.Condition{
+		Status:  metav1.ConditionFalse,
+		Reason:  string(gwv1.PolicyReasonConflicted),
+		Message: "policy key shared is also produced by AgentgatewayPolicy/default/a",
+	})
+	assert.EventuallyEqual(t, accepted("c"), &metav1.Condition{
+		Status: metav1.ConditionTrue,
+		Reason: string(agentgateway.PolicyReasonValid),
+	})
+
+	// Once the collision is gone, both policies are emitted again.
+	policies.DeleteObject(policy("b", "shared").ResourceName())
+	assert.EventuallyEqual(t, keys, []string{"shared", "unique"})
+	assert.EventuallyEqual(t, accepted("a"), &metav1.Condition{
+		Status: metav1.ConditionTrue,
+		Reason: string(agentgateway.PolicyReasonValid),
+	})
+}
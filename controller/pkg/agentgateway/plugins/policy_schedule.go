@@ -0,0 +1,116 @@
+package plugins
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"istio.io/istio/pkg/kube/krt"
+
+	"github.com/agentgateway/agentgateway/controller/api/v1alpha1/agentgateway"
+)
+
+// policyScheduleTick is the value of the PolicyScheduler singleton. Every change to it makes the
+// policies that evaluated an activeSchedule translate again.
+type policyScheduleTick struct {
+	at time.Time
+}
+
+func (policyScheduleTick) ResourceName() string {
+	return "policy-schedule"
+}
+
+// PolicyScheduler evaluates policy activeSchedules and re-triggers their translation when the
+// earliest pending schedule boundary is reached. krt only recomputes on input changes, so the
+// passage of time is modeled as a singleton input that is bumped at each boundary.
+type PolicyScheduler struct {
+	now  func() time.Time
+	tick krt.StaticSingleton[policyScheduleTick]
+	stop <-chan struct{}
+
+	mu sync.Mutex
+	// next is the earliest boundary a timer is pending for; zero when none is.
+	next  time.Time
+	timer *time.Timer
+}
+
+// NewPolicyScheduler returns a scheduler reading the current time from now. Pending timers are
+// dropped once stop is closed.
+func NewPolicyScheduler(stop <-chan struct{}, now func() time.Time) *PolicyScheduler {
+	return &PolicyScheduler{
+		now:  now,
+		tick: krt.NewStatic(&policyScheduleTick{at: now()}, true),
+		stop: stop,
+	}
+}
+
+// Active reports whether schedule is active at the current time. When it is not, the returned
+// message explains why. A translation that calls Active is re-run at the schedule's next boundary.
+func (s *PolicyScheduler) Active(ctx krt.HandlerContext, schedule *agentgateway.PolicySchedule) (bool, string) {
+	now := time.Now()
+	if s != nil {
+		krt.FetchOne(ctx, s.tick.AsCollection())
+		now = s.now()
+	}
+	active, next := scheduleState(schedule, now)
+	if !next.IsZero() {
+		s.requeueAt(next)
+	}
+	if active {
+		return true, ""
+	}
+	if !next.IsZero() {
+		return false, fmt.Sprintf("Policy is outside its activeSchedule; it becomes active at %s", next.UTC().Format(time.RFC3339))
+	}
+	return false, fmt.Sprintf("Policy is outside its activeSchedule, which ended at %s", schedule.End.UTC().Format(time.RFC3339))
+}
+
+// scheduleState returns whether schedule is active at now, and the next time that changes. The
+// next time is zero when the schedule never changes state again.
+func scheduleState(schedule *agentgateway.PolicySchedule, now time.Time) (bool, time.Time) {
+	if schedule.Start != nil && now.Before(schedule.Start.Time) {
+		return false, schedule.Start.Time
+	}
+	if schedule.End != nil {
+		if !now.Before(schedule.End.Time) {
+			return false, time.Time{}
+		}
+		return true, schedule.End.Time
+	}
+	return true, time.Time{}
+}
+
+// requeueAt arranges for scheduled policies to be translated again at t, unless an earlier
+// boundary is already pending; that translation will request the later boundary again.
+func (s *PolicyScheduler) requeueAt(t time.Time) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.next.IsZero() && !t.Before(s.next) {
+		return
+	}
+	if s.timer != nil {
+		s.timer.Stop()
+	}
+	s.next = t
+	s.timer = time.AfterFunc(t.Sub(s.now()), s.fire)
+}
+
+func (s *PolicyScheduler) fire() {
+	select {
+	case <-s.stop:
+		return
+	default:
+	}
+	s.mu.Lock()
+	if s.timer != nil {
+		s.timer.Stop()
+	}
+	s.next = time.Time{}
+	s.timer = nil
+	now := s.now()
+	s.mu.Unlock()
+	s.tick.Set(&policyScheduleTick{at: now})
+}
@@ -0,0 +1,122 @@
+package plugins
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"istio.io/istio/pkg/kube/krt"
+	"istio.io/istio/pkg/test"
+	"istio.io/istio/pkg/test/util/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/agentgateway/agentgateway/controller/api/v1alpha1/agentgateway"
+)
+
+func TestScheduleState(t *testing.T) {
+	start := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	window := &agentgateway.PolicySchedule{Start: &metav1.Time{Time: start}, End: &metav1.Time{Time: end}}
+
+	cases := []struct {
+		name       string
+		schedule   *agentgateway.PolicySchedule
+		now        time.Time
+		wantActive bool
+		wantNext   time.Time
+	}{
+		{name: "before window", schedule: window, now: start.Add(-time.Minute), wantActive: false, wantNext: start},
+		{name: "at start", schedule: window, now: start, wantActive: true, wantNext: end},
+		{name: "within window", schedule: window, now: start.Add(time.Minute), wantActive: true, wantNext: end},
+		{name: "at end", schedule: window, now: end, wantActive: false},
+		{name: "after window", schedule: window, now: end.Add(time.Minute), wantActive: false},
+		{
+			name:       "start only, after start",
+			schedule:   &agentgateway.PolicySchedule{Start: &metav1.Time{Time: start}},
+			now:        end,
+			wantActive: true,
+		},
+		{
+			name:       "end only, before end",
+			schedule:   &agentgateway.PolicySchedule{End: &metav1.Time{Time: end}},
+			now:        start,
+			wantActive: true,
+			wantNext:   end,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			active, next := scheduleState(tt.schedule, tt.now)
+			assert.Equal(t, active, tt.wantActive)
+			assert.Equal(t, next, tt.wantNext)
+		})
+	}
+}
+
+func TestPolicySchedulerRequeuesAtNextBoundary(t *testing.T) {
+	start := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	schedule := &agentgateway.PolicySchedule{Start: &metav1.Time{Time: start}, End: &metav1.Time{Time: end}}
+
+	var mu sync.Mutex
+	now := start.Add(-time.Minute)
+	scheduler := NewPolicyScheduler(test.NewStop(t), func() time.Time {
+		mu.Lock()
+		defer mu.Unlock()
+		return now
+	})
+	setNow := func(at time.Time) {
+		mu.Lock()
+		defer mu.Unlock()
+		now = at
+	}
+	nextBoundary := func() time.Time {
+		scheduler.mu.Lock()
+		defer scheduler.mu.Unlock()
+		return scheduler.next
+	}
+
+	policies := krt.NewStaticCollection(nil, []*agentgateway.AgentgatewayPolicy{{
+		ObjectMeta: metav1.ObjectMeta{Name: "maintenance", Namespace: "default"},
+		Spec:       agentgateway.AgentgatewayPolicySpec{ActiveSchedule: schedule},
+	}}, krt.WithStop(test.NewStop(t)))
+	active := krt.NewCollection(policies, func(ctx krt.HandlerContext, policy *agentgateway.AgentgatewayPolicy) *scheduleResult {
+		ok, message := scheduler.Active(ctx, policy.Spec.ActiveSchedule)
+		return &scheduleResult{Name: policy.Name, Active: ok, Message: message}
+	}, krt.WithStop(test.NewStop(t)))
+	active.WaitUntilSynced(test.NewStop(t))
+
+	assert.EventuallyEqual(t, active.List, []scheduleResult{{
+		Name:    "maintenance",
+		Message: "Policy is outside its activeSchedule; it becomes active at 2026-01-01T10:00:00Z",
+	}})
+	assert.Equal(t, nextBoundary(), start)
+
+	// Reaching the start boundary activates the policy and requeues at the end boundary.
+	setNow(start)
+	scheduler.fire()
+	assert.EventuallyEqual(t, active.List, []scheduleResult{{
+		Name:   "maintenance",
+		Active: true,
+	}})
+	assert.EventuallyEqual(t, nextBoundary, end)
+
+	// Past the end boundary there is nothing left to requeue.
+	setNow(end)
+	scheduler.fire()
+	assert.EventuallyEqual(t, active.List, []scheduleResult{{
+		Name:    "maintenance",
+		Message: "Policy is outside its activeSchedule, which ended at 2026-01-01T11:00:00Z",
+	}})
+	assert.Equal(t, nextBoundary(), time.Time{})
+}
+
+type scheduleResult struct {
+	Name    string
+	Active  bool
+	Message string
+}
+
+func (r scheduleResult) ResourceName() string {
+	return r.Name
+}
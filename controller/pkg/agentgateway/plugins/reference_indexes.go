@@ -65,6 +65,28 @@ func ResourceExists[T controllers.ComparableObject](krtctx krt.HandlerContext, c
 	return len(krt.PartialFetchComparable(krtctx, col, ExtractName, krt.FilterKey(key))) > 0
 }
 
+// httpRouteRuleExists reports whether the HTTPRoute exists and, when a rule name is given, has a rule with that name.
+func httpRouteRuleExists(krtctx krt.HandlerContext, col krt.Collection[*gwv1.HTTPRoute], key string, ruleName *gwv1.SectionName) bool {
+	if ruleName == nil {
+		return ResourceExists(krtctx, col, key)
+	}
+	route := ptr.Flatten(krt.FetchOne(krtctx, col, krt.FilterKey(key)))
+	return route != nil && slices.ContainsFunc(route.Spec.Rules, func(r gwv1.HTTPRouteRule) bool {
+		return ptr.Equal(r.Name, ruleName)
+	})
+}
+
+// grpcRouteRuleExists reports whether the GRPCRoute exists and, when a rule name is given, has a rule with that name.
+func grpcRouteRuleExists(krtctx krt.HandlerContext, col krt.Collection[*gwv1.GRPCRoute], key string, ruleName *gwv1.SectionName) bool {
+	if ruleName == nil {
+		return ResourceExists(krtctx, col, key)
+	}
+	route := ptr.Flatten(krt.FetchOne(krtctx, col, krt.FilterKey(key)))
+	return route != nil && slices.ContainsFunc(route.Spec.Rules, func(r gwv1.GRPCRouteRule) bool {
+		return ptr.Equal(r.Name, ruleName)
+	})
+}
+
 func (e *BackendReferenceError) Error() string {
 	return e.Message
 }
@@ -96,11 +118,15 @@ func DefaultReferenceTypes(agw *AgwCollections) ReferenceTypes {
 			case wellknown.HTTPRouteGVK.GroupKind():
 				return []*api.PolicyTarget{{
 					Kind: utils.RouteTarget(namespace, string(name), wellknown.HTTPRouteGVK.Kind, sectionName),
-				}}, ResourceExists(krtctx, agw.HTTPRoutes, key)
+				}}, httpRouteRuleExists(krtctx, agw.HTTPRoutes, key, sectionName)
 			case wellknown.GRPCRouteGVK.GroupKind():
 				return []*api.PolicyTarget{{
 					Kind: utils.RouteTarget(namespace, string(name), wellknown.GRPCRouteGVK.Kind, sectionName),
-				}}, ResourceExists(krtctx, agw.GRPCRoutes, key)
+				}}, grpcRouteRuleExists(krtctx, agw.GRPCRoutes, key, sectionName)
+			case wellknown.TCPRouteGVK.GroupKind():
+				return []*api.PolicyTarget{{
+					Kind: utils.RouteTarget(namespace, string(name), wellknown.TCPRouteGVK.Kind, sectionName),
+				}}, ResourceExists(krtctx, agw.TCPRoutes, key)
 			case wellknown.AgentgatewayBackendGVK.GroupKind():
 				return []*api.PolicyTarget{{
 					Kind: utils.BackendTarget(namespace, string(name), sectionName),
@@ -148,6 +174,13 @@ func DefaultReferenceTypes(agw *AgwCollections) ReferenceTypes {
 					}}
 					targets = append(targets, ResolvedPolicySelectorTarget{Name: gwv1.ObjectName(route.Name), Namespace: route.Namespace, PolicyTargets: policyTargets})
 				}
+			case wellknown.TCPRouteGVK.GroupKind():
+				for _, route := range krt.Fetch(krtctx, agw.TCPRoutes, krt.FilterLabel(selector.MatchLabels), krt.FilterIndex(agw.TCPRoutesByNamespace, policyNamespace)) {
+					policyTargets := []*api.PolicyTarget{{
+						Kind: utils.RouteTarget(route.Namespace, route.Name, wellknown.TCPRouteGVK.Kind, sectionName),
+					}}
+					targets = append(targets, ResolvedPolicySelectorTarget{Name: gwv1.ObjectName(route.Name), Namespace: route.Namespace, PolicyTargets: policyTargets})
+				}
 			case wellknown.ListenerSetGVK.GroupKind():
 				for _, ls := range krt.Fetch(krtctx, agw.ListenerSets, krt.FilterLabel(selector.MatchLabels), krt.FilterIndex(agw.ListenerSetsByNamespace, policyNamespace)) {
 					policyTargets := []*api.PolicyTarget{{
@@ -3,8 +3,11 @@ package plugins
 import (
 	"testing"
 
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/agentgateway/agentgateway/controller/api/v1alpha1/agentgateway"
 )
 
 func TestMergeAncestorsSummarizesWhenOwnedAncestorFitsInFirst16(t *testing.T) {
@@ -86,3 +89,100 @@ func TestMergeAncestorsTruncatesWhenNoOwnedAncestorFitsInFirst16(t *testing.T) {
 		}
 	}
 }
+
+func TestSetAncestorStatusTracksGeneration(t *testing.T) {
+	const controllerName = "agentgateway.dev/controller"
+	ref := gwv1.ParentReference{Name: "gw"}
+	conds := PolicyConditionMap(nil, true)
+
+	first := SetAncestorStatus(ref, &gwv1.PolicyStatus{}, 1, conds, controllerName)
+	transitions := map[string]metav1.Time{}
+	for _, c := range first.Conditions {
+		if c.ObservedGeneration != 1 {
+			t.Fatalf("expected %s observedGeneration 1, got %d", c.Type, c.ObservedGeneration)
+		}
+		transitions[c.Type] = c.LastTransitionTime
+	}
+
+	// A spec bump with unchanged results must still advance observedGeneration,
+	// without resetting lastTransitionTime.
+	existing := &gwv1.PolicyStatus{Ancestors: []gwv1.PolicyAncestorStatus{first}}
+	second := SetAncestorStatus(ref, existing, 2, conds, controllerName)
+	if len(second.Conditions) != len(first.Conditions) {
+		t.Fatalf("expected %d conditions, got %d", len(first.Conditions), len(second.Conditions))
+	}
+	for _, c := range second.Conditions {
+		if c.ObservedGeneration != 2 {
+			t.Fatalf("expected %s observedGeneration 2, got %d", c.Type, c.ObservedGeneration)
+		}
+		if !c.LastTransitionTime.Equal(new(transitions[c.Type])) {
+			t.Fatalf("expected %s lastTransitionTime to be retained", c.Type)
+		}
+	}
+}
+
+func TestProgrammedConditionMap(t *testing.T) {
+	invalid := categorizedErrorf(PolicyErrorCategoryInvalidValue, "bad value")
+	for _, tc := range []struct {
+		name           string
+		conds          map[string]*Condition
+		wantAccepted   string
+		wantProgrammed string
+		wantStatus     metav1.ConditionStatus
+	}{
+		{
+			name:           "valid",
+			conds:          PolicyConditionMap(nil, true),
+			wantAccepted:   string(agentgateway.PolicyReasonValid),
+			wantProgrammed: string(agentgateway.PolicyReasonProgrammed),
+			wantStatus:     metav1.ConditionTrue,
+		},
+		{
+			name:           "partially valid",
+			conds:          PolicyConditionMap(invalid, true),
+			wantAccepted:   string(agentgateway.PolicyReasonPartiallyValid),
+			wantProgrammed: string(agentgateway.PolicyReasonProgrammed),
+			wantStatus:     metav1.ConditionTrue,
+		},
+		{
+			name:           "invalid",
+			conds:          PolicyConditionMap(invalid, false),
+			wantAccepted:   string(agentgateway.PolicyReasonInvalidValue),
+			wantProgrammed: string(agentgateway.PolicyReasonInvalid),
+			wantStatus:     metav1.ConditionFalse,
+		},
+		{
+			name:           "not attached",
+			conds:          attachmentErrorConditionMap(PolicyConditionMap(nil, true), []string{"Policy is not attached"}, true),
+			wantAccepted:   string(agentgateway.PolicyReasonValid),
+			wantProgrammed: string(agentgateway.PolicyReasonPending),
+			wantStatus:     metav1.ConditionFalse,
+		},
+		{
+			name:           "conflicted",
+			conds:          conflictedConditionMap(PolicyConditionMap(nil, true), "conflict"),
+			wantAccepted:   string(gwv1.PolicyReasonConflicted),
+			wantProgrammed: string(agentgateway.PolicyReasonInvalid),
+			wantStatus:     metav1.ConditionFalse,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			conds := setConditions(1, nil, programmedConditionMap(tc.conds))
+
+			accepted := meta.FindStatusCondition(conds, string(agentgateway.PolicyConditionAccepted))
+			programmed := meta.FindStatusCondition(conds, string(agentgateway.PolicyConditionProgrammed))
+			if accepted == nil || programmed == nil {
+				t.Fatalf("expected Accepted and Programmed conditions, got %+v", conds)
+			}
+			if accepted.Reason != tc.wantAccepted {
+				t.Fatalf("expected Accepted reason %s, got %s", tc.wantAccepted, accepted.Reason)
+			}
+			if programmed.Status != tc.wantStatus || programmed.Reason != tc.wantProgrammed {
+				t.Fatalf("expected Programmed %s/%s, got %s/%s", tc.wantStatus, tc.wantProgrammed, programmed.Status, programmed.Reason)
+			}
+			if _, ok := tc.conds[string(agentgateway.PolicyConditionProgrammed)]; ok {
+				t.Fatal("expected input conditions not to be modified")
+			}
+		})
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"errors"
 	"fmt"
 	"iter"
+	"math"
+	"net/http"
 	"strconv"
 	"strings"
 	"time"
@@ -22,7 +24,10 @@ import (
 	"istio.io/istio/pkg/ptr"
 	"istio.io/istio/pkg/slices"
 	"istio.io/istio/pkg/util/protomarshal"
+	"istio.io/istio/pkg/util/sets"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
@@ -31,6 +36,7 @@ import (
 	"github.com/agentgateway/agentgateway/api"
 	"github.com/agentgateway/agentgateway/controller/api/v1alpha1/agentgateway"
 	"github.com/agentgateway/agentgateway/controller/pkg/agentgateway/jwks"
+	"github.com/agentgateway/agentgateway/controller/pkg/agentgateway/policyselection"
 	"github.com/agentgateway/agentgateway/controller/pkg/agentgateway/remotehttp"
 	"github.com/agentgateway/agentgateway/controller/pkg/agentgateway/utils"
 	"github.com/agentgateway/agentgateway/controller/pkg/logging"
@@ -44,6 +50,7 @@ const (
 	extauthPolicySuffix            = ":extauth"
 	extprocPolicySuffix            = ":extproc"
 	rbacPolicySuffix               = ":rbac"
+	defaultDenyPolicySuffix        = ":default-deny"
 	localRateLimitPolicySuffix     = ":rl-local"
 	globalRateLimitPolicySuffix    = ":rl-global"
 	transformationPolicySuffix     = ":transformation"
@@ -52,6 +59,7 @@ const (
 	headerModifierPolicySuffix     = ":header-modifier"
 	respHeaderModifierPolicySuffix = ":resp-header-modifier"
 	hostnameRewritePolicySuffix    = ":hostname-rewrite"
+	httpsRedirectPolicySuffix      = ":https-redirect"
 	retryPolicySuffix              = ":retry"
 	timeoutPolicySuffix            = ":timeout"
 	delayPolicySuffix              = ":delay"
@@ -60,6 +68,8 @@ const (
 	apiKeyPolicySuffix             = ":apikeyauth" //nolint:gosec
 	directResponseSuffix           = ":direct-response"
 	bufferSuffix                   = ":buffer"
+	metadataHeadersSuffix          = ":metadata-headers"
+	tcpIdleTimeoutSuffix           = ":tcp-idle-timeout"
 )
 
 var logger = logging.New("agentgateway/plugins")
@@ -104,6 +114,7 @@ func NewAgentPlugin(agw *AgwCollections, resolver remotehttp.Resolver, jwksLooku
 					) {
 						return TranslateAgentgatewayPolicy(krtctx, policyCR, agw, input.References, input.Grants, resolver, jwksLookup, credentialResolver)
 					}, agw.KrtOpts.ToOptions("policies/Agentgateway")...)
+					trackEmittedPolicies(wellknown.AgentgatewayPolicyGVK.Kind, policyCol)
 					return ConvertStatusCollection(policyStatusCol, agw.KrtOpts.ToOptions, "policies/Agentgateway"), policyCol
 				},
 				BuildReferences: func(input PolicyPluginInput) krt.Collection[*PolicyAttachment] {
@@ -160,6 +171,33 @@ func (ctx PolicyCtx) ResolveCredentialKeyRef(ref agentgateway.LocalSecretKeyRef,
 	return data, key, err
 }
 
+// ResolveSecretRef resolves a credential ref that may point into another
+// namespace. A ref into another namespace must be permitted by a ReferenceGrant.
+func (ctx PolicyCtx) ResolveSecretRef(ref agentgateway.SecretObjectRef, namespace string) (map[string][]byte, error) {
+	if secretNamespace := ref.ResolvedNamespace(namespace); secretNamespace != namespace {
+		secret := types.NamespacedName{Namespace: secretNamespace, Name: string(ref.Name)}
+		if ctx.Grants == nil {
+			return nil, categorizedErrorf(PolicyErrorCategoryInvalidValue, "secretRef %s: cross-namespace credential refs are not supported here", secret)
+		}
+		if !ctx.Grants.SecretAllowed(ctx.Krt, ctx.PolicySourceGVK(), secret, namespace) {
+			return nil, categorizedErrorf(PolicyErrorCategoryReferenceNotPermitted, "secretRef %s not accessible to %s in namespace %q (missing a ReferenceGrant?)", secret, ctx.PolicySourceGVK().Kind, namespace)
+		}
+		namespace = secretNamespace
+	}
+	return ctx.ResolveCredentialRef(ref.LocalRef(), namespace)
+}
+
+// ResolveSecretKeyRef is ResolveSecretRef for refs with a key override, using
+// defaultKey when the ref does not override it.
+func (ctx PolicyCtx) ResolveSecretKeyRef(ref agentgateway.SecretKeyRef, namespace, defaultKey string) (map[string][]byte, string, error) {
+	key := defaultKey
+	if ref.Key != nil && *ref.Key != "" {
+		key = *ref.Key
+	}
+	data, err := ctx.ResolveSecretRef(ref.ObjectRef(), namespace)
+	return data, key, err
+}
+
 type ResolvedTarget struct {
 	AgentgatewayTarget *api.PolicyTarget
 	GatewayTargets     []types.NamespacedName
@@ -178,6 +216,7 @@ func TranslateAgentgatewayPolicy(
 	jwksLookup jwks.Lookup,
 	credentialResolver kubeutils.CredentialResolver,
 ) (*gwv1.PolicyStatus, []AgwPolicy) {
+	finishMetrics := collectPolicyTranslationMetrics(wellknown.AgentgatewayPolicyGVK.Kind)
 	var agwPolicies []AgwPolicy
 	existingStatus := policy.Status.DeepCopy()
 
@@ -193,12 +232,36 @@ func TranslateAgentgatewayPolicy(
 	}
 	var ancestors []gwv1.PolicyAncestorStatus
 	var attachmentErrors []string
-	// TODO: add selectors
-	baseTranslatedPolicies, baseErr := TranslatePolicyToAgw(pctx, policy)
-	baseConds := PolicyConditionMap(baseErr, len(baseTranslatedPolicies) > 0)
+	// missingTargets counts the attachment errors caused by targets that do not exist.
+	missingTargets := 0
+	// A disabled or inactive policy is not translated at all; its targets are still resolved so
+	// that every ancestor reports why the policy is not applied.
+	notApplied := false
+	var baseTranslatedPolicies []*api.Policy
+	var baseErr error
+	var baseConds map[string]*Condition
+	if !policy.Spec.IsEnabled() {
+		notApplied = true
+		baseConds = disabledConditionMap()
+	} else if schedule := policy.Spec.ActiveSchedule; schedule != nil {
+		if active, message := agw.PolicyScheduler.Active(ctx, schedule); !active {
+			notApplied = true
+			baseConds = inactiveConditionMap(message)
+		}
+	}
+	if !notApplied {
+		// TODO: add selectors
+		baseTranslatedPolicies, baseErr = TranslatePolicyToAgw(pctx, policy)
+		baseConds = PolicyConditionMap(baseErr, len(baseTranslatedPolicies) > 0)
+	}
 	controller := gwv1.GatewayController(agw.ControllerName)
+	defer func() {
+		finishMetrics(policyRejectedReason(ancestors, baseConds))
+	}()
 
-	processTarget := func(name gwv1.ObjectName, targetNamespace string, gk schema.GroupKind, policyTargets []*api.PolicyTarget, targetExists bool) {
+	// Ancestors reported with rejected conditions; a later accepted target on the same Gateway replaces them.
+	rejectedAncestors := sets.New[int]()
+	processTarget := func(name gwv1.ObjectName, targetNamespace string, gk schema.GroupKind, sectionName *gwv1.SectionName, policyTargets []*api.PolicyTarget, targetExists bool) {
 		if len(policyTargets) == 0 {
 			logger.Warn("unsupported target kind", "kind", gk.Kind, "policy", policy.Name)
 			return
@@ -209,15 +272,41 @@ func TranslateAgentgatewayPolicy(
 			Kind:           gk.Kind,
 		}
 
+		// Conflicts and unsupported settings are evaluated per target, so rejecting one target does not affect the others.
+		targetConds := baseConds
+		// A policy that is not applied emits nothing for any target, so the per-target checks are skipped.
+		rejected := notApplied
+		if !notApplied {
+			if conflict := conflictingBackendTLSPolicy(ctx, agw, policy, gk, name, sectionName); conflict != nil {
+				targetConds = conflictedConditionMap(baseConds, fmt.Sprintf(
+					"policy %s sets backend.tls for %s %s/%s with higher priority", conflict.Name, gk.Kind, targetNamespace, name))
+				rejected = true
+			} else if unsupported := targetUnsupportedFields(policy, gk); len(unsupported) > 0 {
+				targetConds = unsupportedTargetConditionMap(baseConds, fmt.Sprintf(
+					"%s cannot be applied to %s %s/%s", strings.Join(unsupported, ", "), gk.Kind, targetNamespace, name))
+				rejected = true
+			}
+		}
+		targetTranslatedPolicies := baseTranslatedPolicies
+		if warning := httpsRedirectListenerWarning(ctx, agw, policy, gk, targetNamespace, name, sectionName); warning != "" && !rejected {
+			targetTranslatedPolicies = slices.Filter(baseTranslatedPolicies, func(p *api.Policy) bool {
+				return !strings.HasSuffix(p.Key, httpsRedirectPolicySuffix)
+			})
+			targetConds = warningConditionMap(baseConds, warning)
+		}
+
 		for _, policyTarget := range policyTargets {
 			// For backend-like targets, skip gateway resolution when the target doesn't exist.
 			// A missing backend could still resolve via PolicyAttachments if another backend
 			// chain happens to reference the same name, which would push config for a phantom target.
-			// Gateway/route targets use direct lookup (no PolicyAttachments), so they're safe.
+			// Gateway/route targets use direct lookup (no PolicyAttachments), so they're safe, except
+			// for a missing rule on an existing route, which would otherwise resolve via the route.
 			var gatewayTargets []types.NamespacedName
-			if !IsBackendLikeTarget(policyTarget) || targetExists {
+			if targetExists || !(IsBackendLikeTarget(policyTarget) || policyTarget.GetRoute().GetRouteRule() != "") {
 				gatewayTargets = references.LookupGatewaysForPolicyTarget(ctx, targetObject, policyTarget).UnsortedList()
-				translatedPolicies := ClonePoliciesForTarget(baseTranslatedPolicies, policyTarget)
+			}
+			if !rejected {
+				translatedPolicies := ClonePoliciesForTarget(targetTranslatedPolicies, policyTarget)
 				for _, translatedPolicy := range translatedPolicies {
 					for _, gatewayTarget := range gatewayTargets {
 						agwPolicies = append(agwPolicies, AgwPolicy{
@@ -228,20 +317,30 @@ func TranslateAgentgatewayPolicy(
 				}
 			}
 
-			ancestorRefs, attachmentErr := resolvePolicyAncestorRefs(targetNamespace, targetObject, gatewayTargets, targetExists)
+			ancestorRefs, attachmentErr := resolvePolicyAncestorRefs(targetNamespace, targetObject, sectionName, gatewayTargets, targetExists)
 			if attachmentErr != "" {
 				attachmentErrors = append(attachmentErrors, attachmentErr)
+				if !targetExists {
+					missingTargets++
+				}
 			}
 
 			for _, ar := range ancestorRefs {
 				// A policy should report at most one status per Gateway parent, even if multiple
 				// targetRefs/targetSelectors resolve to the same Gateway.
-				if slices.IndexFunc(ancestors, func(existing gwv1.PolicyAncestorStatus) bool {
+				if idx := slices.IndexFunc(ancestors, func(existing gwv1.PolicyAncestorStatus) bool {
 					return existing.ControllerName == controller && ParentRefEquals(existing.AncestorRef, ar)
-				}) != -1 {
+				}); idx != -1 {
+					if !rejected && rejectedAncestors.Contains(idx) {
+						ancestors[idx] = SetAncestorStatus(ar, existingStatus, policy.Generation, programmedConditionMap(targetConds), controller)
+						rejectedAncestors.Delete(idx)
+					}
 					continue
 				}
-				ancestors = append(ancestors, SetAncestorStatus(ar, existingStatus, policy.Generation, baseConds, controller))
+				if rejected {
+					rejectedAncestors.Insert(len(ancestors))
+				}
+				ancestors = append(ancestors, SetAncestorStatus(ar, existingStatus, policy.Generation, programmedConditionMap(targetConds), controller))
 			}
 		}
 	}
@@ -254,46 +353,109 @@ func TranslateAgentgatewayPolicy(
 		SectionName string
 		Port        int32
 	}
+	type resolvedTarget struct {
+		gk            schema.GroupKind
+		name          gwv1.ObjectName
+		namespace     string
+		sectionName   *gwv1.SectionName
+		policyTargets []*api.PolicyTarget
+		exists        bool
+	}
+	// Targets from targetRefs and targetSelectors are resolved up front and merged into their
+	// union, so a target that is both referenced and selected is only processed once.
 	seen := make(map[targetKey]struct{})
-	tryProcessTarget := func(gk schema.GroupKind, name gwv1.ObjectName, sectionName *gwv1.SectionName, port *gwv1.PortNumber, targetNamespace string) {
+	var targets []resolvedTarget
+	addTarget := func(target resolvedTarget, port *int32) {
 		section := ""
-		if sectionName != nil {
-			section = string(*sectionName)
+		if target.sectionName != nil {
+			section = string(*target.sectionName)
 		}
-		var portNum int32
-		if port != nil {
-			portNum = int32(*port)
-		}
-		key := targetKey{Group: gk.Group, Kind: gk.Kind, Name: string(name), Namespace: targetNamespace, SectionName: section, Port: portNum}
+		key := targetKey{Group: target.gk.Group, Kind: target.gk.Kind, Name: string(target.name), Namespace: target.namespace, SectionName: section, Port: ptr.OrEmpty(port)}
 		if _, ok := seen[key]; ok {
 			return
 		}
 		seen[key] = struct{}{}
-		policyTargets, targetExists := references.PolicyTarget(ctx, targetNamespace, name, gk, sectionName, port)
-		processTarget(name, targetNamespace, gk, policyTargets, targetExists)
+		targets = append(targets, target)
 	}
 
 	for _, target := range policy.Spec.TargetRefs {
 		gk := schema.GroupKind{Group: string(target.Group), Kind: string(target.Kind)}
-		tryProcessTarget(gk, target.Name, target.SectionName, target.Port, policy.Namespace)
-	}
+		policyTargets, targetExists := references.PolicyTarget(ctx, policy.Namespace, target.Name, gk, target.SectionName, target.Port)
+		var port *int32
+		if target.Port != nil {
+			port = new(int32(*target.Port))
+		}
+		addTarget(resolvedTarget{
+			gk:            gk,
+			name:          target.Name,
+			namespace:     policy.Namespace,
+			sectionName:   target.SectionName,
+			policyTargets: policyTargets,
+			exists:        targetExists,
+		}, port)
+	}
+	refTargets := len(targets)
+
+	// Selectors are resolved before any target is processed so the number of matched
+	// targets can be reported on every ancestor.
+	resolvedSelectorTargets := sets.New[utils.TypedNamespacedName]()
+	selectedKinds := sets.New[schema.GroupKind]()
 	for _, selector := range policy.Spec.TargetSelectors {
 		gk := schema.GroupKind{Group: string(selector.Group), Kind: string(selector.Kind)}
-		targets := references.PolicyTargetsBySelector(ctx, policy.Namespace, selector)
-		if len(targets) == 0 {
+		selectedKinds.Insert(gk)
+		selected := references.PolicyTargetsBySelector(ctx, policy.Namespace, selector)
+		if len(selected) == 0 {
 			attachmentErrors = append(attachmentErrors, fmt.Sprintf("Policy is not attached: no %s matching selector found in namespace %s", gk.Kind, policy.Namespace))
 		}
-		for _, target := range targets {
-			processTarget(target.Name, target.Namespace, gk, target.PolicyTargets, true)
+		for _, target := range selected {
+			addTarget(resolvedTarget{
+				gk:            gk,
+				name:          target.Name,
+				namespace:     target.Namespace,
+				sectionName:   selector.SectionName,
+				policyTargets: target.PolicyTargets,
+				exists:        true,
+			}, selector.Port)
+			resolvedSelectorTargets.Insert(utils.TypedNamespacedName{
+				NamespacedName: types.NamespacedName{Namespace: target.Namespace, Name: string(target.Name)},
+				Kind:           gk.Kind,
+			})
+		}
+	}
+	if len(policy.Spec.TargetSelectors) > 0 {
+		baseConds = resolvedTargetsConditionMap(baseConds, resolvedSelectorTargets.Len())
+		// A directly referenced target that a selector of the same kind does not match is still
+		// attached, but the contradiction is surfaced since the author likely expected it to match.
+		var unselected []string
+		for _, target := range targets[:refTargets] {
+			typed := utils.TypedNamespacedName{
+				NamespacedName: types.NamespacedName{Namespace: target.namespace, Name: string(target.name)},
+				Kind:           target.gk.Kind,
+			}
+			if target.exists && selectedKinds.Contains(target.gk) && !resolvedSelectorTargets.Contains(typed) {
+				unselected = append(unselected, fmt.Sprintf("%s %s/%s", target.gk.Kind, target.namespace, target.name))
+			}
+		}
+		if len(unselected) > 0 {
+			noun, verb := "targetRefs", "are"
+			if len(unselected) == 1 {
+				noun, verb = "targetRef", "is"
+			}
+			baseConds = warningConditionMap(baseConds, fmt.Sprintf(
+				"%s %s %s not matched by targetSelectors of the same kind; the policy applies to the union of targetRefs and targetSelectors",
+				noun, strings.Join(unselected, ", "), verb))
 		}
 	}
+	for _, target := range targets {
+		processTarget(target.name, target.namespace, target.gk, target.sectionName, target.policyTargets, target.exists)
+	}
 
 	if len(attachmentErrors) > 0 {
 		logger.Warn("failed to resolve one or more ancestor refs", "errors", attachmentErrors)
 		ancestors = append(ancestors, SetAncestorStatus(gwv1.ParentReference{
 			Group: new(gwv1.Group(wellknown.AgentgatewayPolicyGVK.Group)),
 			Name:  "StatusSummary",
-		}, existingStatus, policy.Generation, attachmentErrorConditionMap(baseConds, attachmentErrors), controller))
+		}, existingStatus, policy.Generation, programmedConditionMap(attachmentErrorConditionMap(baseConds, attachmentErrors, missingTargets == len(attachmentErrors))), controller))
 	}
 
 	// Build final status from accumulated ancestors
@@ -319,14 +481,15 @@ func PolicyConditionMap(err error, hasTranslatedPolicies bool) map[string]*Condi
 			conds[string(agentgateway.PolicyConditionAccepted)] = &Condition{
 				Status:  metav1.ConditionTrue,
 				Reason:  string(agentgateway.PolicyReasonPartiallyValid),
-				Message: err.Error(),
+				Message: policyErrorMessage(err),
 			}
 		} else {
-			// No policies produced and error present -> invalid
+			// No policies produced and error present -> invalid, with a more specific reason
+			// when every error shares a category
 			conds[string(agentgateway.PolicyConditionAccepted)] = &Condition{
 				Status:  metav1.ConditionFalse,
-				Reason:  string(agentgateway.PolicyReasonInvalid),
-				Message: err.Error(),
+				Reason:  string(policyErrorReason(err)),
+				Message: policyErrorMessage(err),
 			}
 			conds[string(agentgateway.PolicyConditionAttached)] = &Condition{
 				Status:  metav1.ConditionFalse,
@@ -351,11 +514,343 @@ func PolicyConditionMap(err error, hasTranslatedPolicies bool) map[string]*Condi
 	return conds
 }
 
-func attachmentErrorConditionMap(baseConds map[string]*Condition, attachmentErrors []string) map[string]*Condition {
+// policyRejectedReason returns the reason the policy was fully or partially rejected, derived from
+// the Accepted conditions reported on its ancestors, or an empty string if it was accepted everywhere.
+// A policy rejected for a single target, or one whose targets were not found, is counted as well.
+// baseConds is used when the policy reported no ancestors.
+func policyRejectedReason(ancestors []gwv1.PolicyAncestorStatus, baseConds map[string]*Condition) string {
+	rejected := func(status metav1.ConditionStatus, reason string) bool {
+		return status != metav1.ConditionTrue || reason == string(agentgateway.PolicyReasonPartiallyValid)
+	}
+	targetNotFound := false
+	for _, ancestor := range ancestors {
+		if accepted := meta.FindStatusCondition(ancestor.Conditions, string(agentgateway.PolicyConditionAccepted)); accepted != nil &&
+			rejected(accepted.Status, accepted.Reason) {
+			return accepted.Reason
+		}
+		if attached := meta.FindStatusCondition(ancestor.Conditions, string(agentgateway.PolicyConditionAttached)); attached != nil &&
+			attached.Reason == string(gwv1.PolicyReasonTargetNotFound) {
+			targetNotFound = true
+		}
+	}
+	if targetNotFound {
+		return string(gwv1.PolicyReasonTargetNotFound)
+	}
+	if len(ancestors) == 0 {
+		if accepted := baseConds[string(agentgateway.PolicyConditionAccepted)]; accepted != nil && rejected(accepted.Status, accepted.Reason) {
+			return accepted.Reason
+		}
+	}
+	return ""
+}
+
+// disabledConditionMap returns the conditions reported for a policy with `enabled: false`.
+func disabledConditionMap() map[string]*Condition {
+	return map[string]*Condition{
+		string(agentgateway.PolicyConditionAccepted): {
+			Status:  metav1.ConditionFalse,
+			Reason:  string(agentgateway.PolicyReasonDisabled),
+			Message: "Policy is disabled (spec.enabled is false)",
+		},
+		string(agentgateway.PolicyConditionAttached): {
+			Status:  metav1.ConditionFalse,
+			Reason:  string(agentgateway.PolicyReasonDisabled),
+			Message: "Policy is not attached because it is disabled",
+		},
+	}
+}
+
+// inactiveConditionMap returns the conditions reported for a policy outside its activeSchedule.
+func inactiveConditionMap(message string) map[string]*Condition {
+	return map[string]*Condition{
+		string(agentgateway.PolicyConditionAccepted): {
+			Status:  metav1.ConditionFalse,
+			Reason:  string(agentgateway.PolicyReasonInactive),
+			Message: message,
+		},
+		string(agentgateway.PolicyConditionAttached): {
+			Status:  metav1.ConditionFalse,
+			Reason:  string(agentgateway.PolicyReasonInactive),
+			Message: "Policy is not attached because it is inactive",
+		},
+	}
+}
+
+// programmedConditionMap adds the Programmed condition derived from the Accepted and Attached
+// conditions. A policy that is accepted and attached is part of the configuration pushed to the
+// ancestor's proxies, so Programmed is set optimistically rather than waiting for acknowledgement.
+func programmedConditionMap(conds map[string]*Condition) map[string]*Condition {
+	accepted := conds[string(agentgateway.PolicyConditionAccepted)]
+	attached := conds[string(agentgateway.PolicyConditionAttached)]
+	programmed := &Condition{
+		Status:  metav1.ConditionTrue,
+		Reason:  string(agentgateway.PolicyReasonProgrammed),
+		Message: reporter.PolicyProgrammedMsg,
+	}
+	switch {
+	case accepted != nil && accepted.Reason == string(agentgateway.PolicyReasonDisabled):
+		programmed = &Condition{
+			Status:  metav1.ConditionFalse,
+			Reason:  string(agentgateway.PolicyReasonDisabled),
+			Message: "Policy is not programmed because it is disabled",
+		}
+	case accepted != nil && accepted.Reason == string(agentgateway.PolicyReasonInactive):
+		programmed = &Condition{
+			Status:  metav1.ConditionFalse,
+			Reason:  string(agentgateway.PolicyReasonInactive),
+			Message: "Policy is not programmed because it is inactive",
+		}
+	case accepted == nil || accepted.Status != metav1.ConditionTrue:
+		programmed = &Condition{
+			Status:  metav1.ConditionFalse,
+			Reason:  string(agentgateway.PolicyReasonInvalid),
+			Message: "Policy is not programmed because it is not accepted",
+		}
+	case attached != nil && attached.Status != metav1.ConditionTrue:
+		programmed = &Condition{
+			Status:  metav1.ConditionFalse,
+			Reason:  string(agentgateway.PolicyReasonPending),
+			Message: "Policy is not programmed because it is not attached",
+		}
+	}
+	out := maps.Clone(conds)
+	out[string(agentgateway.PolicyConditionProgrammed)] = programmed
+	return out
+}
+
+// conflictedConditionMap returns the conditions reported for a target this policy
+// is not applied to because a higher priority policy conflicts with it.
+func conflictedConditionMap(baseConds map[string]*Condition, message string) map[string]*Condition {
+	conds := maps.Clone(baseConds)
+	conds[string(agentgateway.PolicyConditionAccepted)] = &Condition{
+		Status:  metav1.ConditionFalse,
+		Reason:  string(gwv1.PolicyReasonConflicted),
+		Message: message,
+	}
+	conds[string(agentgateway.PolicyConditionAttached)] = &Condition{
+		Status:  metav1.ConditionFalse,
+		Reason:  string(agentgateway.PolicyReasonPending),
+		Message: "Policy is not attached due to conflict",
+	}
+	return conds
+}
+
+// unsupportedTargetConditionMap returns the conditions reported for a target that does not support
+// part of this policy. The policy is not applied to that target.
+func unsupportedTargetConditionMap(baseConds map[string]*Condition, message string) map[string]*Condition {
 	conds := maps.Clone(baseConds)
+	conds[string(agentgateway.PolicyConditionAccepted)] = &Condition{
+		Status:  metav1.ConditionFalse,
+		Reason:  string(agentgateway.PolicyReasonInvalid),
+		Message: message,
+	}
 	conds[string(agentgateway.PolicyConditionAttached)] = &Condition{
 		Status:  metav1.ConditionFalse,
 		Reason:  string(agentgateway.PolicyReasonPending),
+		Message: "Policy is not attached to an unsupported target",
+	}
+	return conds
+}
+
+// targetUnsupportedFields returns the traffic fields set on the policy that do not apply to the
+// target kind gk, so they are rejected rather than silently ignored.
+//
+// For GRPCRoute targets these are the settings that only apply to plain HTTP requests: gRPC clients
+// neither send CORS preflights nor follow redirects, and CSRF protection relies on browser form semantics.
+// traffic.tcp only applies to TCPRoute targets, and TCPRoute targets carry no HTTP requests, so no
+// other traffic settings apply to them.
+func targetUnsupportedFields(policy *agentgateway.AgentgatewayPolicy, gk schema.GroupKind) []string {
+	traffic := policy.Spec.Traffic
+	if traffic == nil {
+		return nil
+	}
+	var fields []string
+	switch gk {
+	case wellknown.TCPRouteGVK.GroupKind():
+		other := *traffic
+		other.Phase = nil
+		other.TCP = nil
+		if !equality.Semantic.DeepEqual(other, agentgateway.Traffic{}) {
+			fields = append(fields, "traffic settings other than traffic.tcp")
+		}
+		return fields
+	case wellknown.GRPCRouteGVK.GroupKind():
+		if traffic.Cors != nil {
+			fields = append(fields, "traffic.cors")
+		}
+		if traffic.Csrf != nil {
+			fields = append(fields, "traffic.csrf")
+		}
+		if traffic.HTTPSRedirect != nil {
+			fields = append(fields, "traffic.httpsRedirect")
+		}
+	}
+	if traffic.TCP != nil {
+		fields = append(fields, "traffic.tcp")
+	}
+	return fields
+}
+
+// warningConditionMap returns the conditions reported for a target where part of the policy
+// is accepted but not applied. Invalid policies keep their existing conditions.
+func warningConditionMap(baseConds map[string]*Condition, warning string) map[string]*Condition {
+	accepted := baseConds[string(agentgateway.PolicyConditionAccepted)]
+	if accepted == nil || accepted.Status != metav1.ConditionTrue {
+		return baseConds
+	}
+	message := warning
+	if accepted.Reason == string(agentgateway.PolicyReasonPartiallyValid) {
+		message = accepted.Message + "; " + warning
+	}
+	conds := maps.Clone(baseConds)
+	conds[string(agentgateway.PolicyConditionAccepted)] = &Condition{
+		Status:  metav1.ConditionTrue,
+		Reason:  string(agentgateway.PolicyReasonPartiallyValid),
+		Message: message,
+	}
+	return conds
+}
+
+// httpsRedirectListenerWarning returns a warning when an httpsRedirect policy targets a Gateway
+// or ListenerSet and any targeted listener does not accept plaintext HTTP. Redirecting requests
+// that already use HTTPS would loop, so the redirect is only applied when every targeted listener
+// is plaintext HTTP. Without a sectionName, a Gateway target also covers the listeners of its
+// ListenerSets.
+func httpsRedirectListenerWarning(
+	ctx krt.HandlerContext,
+	agw *AgwCollections,
+	policy *agentgateway.AgentgatewayPolicy,
+	gk schema.GroupKind,
+	namespace string,
+	name gwv1.ObjectName,
+	sectionName *gwv1.SectionName,
+) string {
+	if policy.Spec.Traffic == nil || policy.Spec.Traffic.HTTPSRedirect == nil {
+		return ""
+	}
+	targetName := types.NamespacedName{Namespace: namespace, Name: string(name)}
+	var notPlaintext []string
+	check := func(listener gwv1.SectionName, protocol gwv1.ProtocolType) {
+		if sectionName != nil && listener != *sectionName {
+			return
+		}
+		if protocol != gwv1.HTTPProtocolType {
+			notPlaintext = append(notPlaintext, string(listener))
+		}
+	}
+	switch gk {
+	case wellknown.GatewayGVK.GroupKind():
+		gw := ptr.Flatten(krt.FetchOne(ctx, agw.Gateways, krt.FilterKey(targetName.String())))
+		if gw == nil {
+			return ""
+		}
+		for _, l := range gw.Spec.Listeners {
+			check(l.Name, l.Protocol)
+		}
+		if sectionName == nil {
+			for _, ls := range krt.Fetch(ctx, agw.ListenerSets, krt.FilterGeneric(func(o any) bool {
+				ls := o.(*gwv1.ListenerSet)
+				parent := ls.Spec.ParentRef
+				return string(parent.Name) == gw.Name &&
+					string(ptr.OrDefault(parent.Namespace, gwv1.Namespace(ls.Namespace))) == gw.Namespace
+			})) {
+				for _, l := range ls.Spec.Listeners {
+					check(l.Name, l.Protocol)
+				}
+			}
+		}
+	case wellknown.ListenerSetGVK.GroupKind():
+		ls := ptr.Flatten(krt.FetchOne(ctx, agw.ListenerSets, krt.FilterKey(targetName.String())))
+		if ls == nil {
+			return ""
+		}
+		for _, l := range ls.Spec.Listeners {
+			check(l.Name, l.Protocol)
+		}
+	default:
+		return ""
+	}
+	if len(notPlaintext) == 0 {
+		return ""
+	}
+	if sectionName != nil {
+		return fmt.Sprintf("httpsRedirect is not applied to listener %s on %s %s: listener does not accept plaintext HTTP", *sectionName, gk.Kind, targetName)
+	}
+	return fmt.Sprintf("httpsRedirect is not applied to %s %s: listeners %s do not accept plaintext HTTP; set sectionName to a plaintext HTTP listener",
+		gk.Kind, targetName, strings.Join(sets.SortedList(sets.New(notPlaintext...)), ", "))
+}
+
+// conflictingBackendTLSPolicy returns a higher priority AgentgatewayPolicy that sets backend.tls
+// for the same targetRef as this policy, or nil if there is none. Unlike most settings, backend TLS
+// from multiple policies cannot be merged, so only the highest priority policy applies to a target.
+// Matching mirrors BackendTLSPolicy conflict detection: only targetRefs are considered.
+func conflictingBackendTLSPolicy(
+	ctx krt.HandlerContext,
+	agw *AgwCollections,
+	policy *agentgateway.AgentgatewayPolicy,
+	gk schema.GroupKind,
+	name gwv1.ObjectName,
+	sectionName *gwv1.SectionName,
+) *agentgateway.AgentgatewayPolicy {
+	if policy.Spec.Backend == nil || policy.Spec.Backend.TLS == nil || agw.AgentgatewayPolicies == nil {
+		return nil
+	}
+	var conflict *agentgateway.AgentgatewayPolicy
+	for _, other := range krt.Fetch(ctx, agw.AgentgatewayPolicies, krt.FilterIndex(agw.AgentgatewayPoliciesByNamespace, policy.Namespace)) {
+		if other.Name == policy.Name {
+			continue
+		}
+		if other.Spec.Backend == nil || other.Spec.Backend.TLS == nil || !other.Spec.IsEnabled() {
+			continue
+		}
+		if !slices.ContainsFunc(other.Spec.TargetRefs, func(ref agentgateway.LocalPolicyTargetReferenceWithSectionName) bool {
+			return string(ref.Group) == gk.Group &&
+				string(ref.Kind) == gk.Kind &&
+				ref.Name == name &&
+				ptr.Equal(ref.SectionName, sectionName)
+		}) {
+			continue
+		}
+		if policyselection.HasHigherPriority(other, policy) && (conflict == nil || policyselection.HasHigherPriority(other, conflict)) {
+			conflict = other
+		}
+	}
+	return conflict
+}
+
+// resolvedTargetsConditionMap reports how many targets a selector policy resolved to in the
+// Attached condition, so operators can confirm the selectors matched what they expected.
+func resolvedTargetsConditionMap(baseConds map[string]*Condition, resolved int) map[string]*Condition {
+	attached := baseConds[string(agentgateway.PolicyConditionAttached)]
+	if attached == nil || attached.Status != metav1.ConditionTrue {
+		return baseConds
+	}
+	noun := "targets"
+	if resolved == 1 {
+		noun = "target"
+	}
+	conds := maps.Clone(baseConds)
+	conds[string(agentgateway.PolicyConditionAttached)] = &Condition{
+		Status:  attached.Status,
+		Reason:  attached.Reason,
+		Message: fmt.Sprintf("%s (targetSelectors resolved %d %s)", attached.Message, resolved, noun),
+	}
+	return conds
+}
+
+// attachmentErrorConditionMap returns the conditions reported on the status summary when some
+// targets could not be attached. When every error is a missing target the Attached reason is
+// TargetNotFound, so a dangling targetRef is distinguishable from a target that exists but is
+// not (yet) attached to a Gateway.
+func attachmentErrorConditionMap(baseConds map[string]*Condition, attachmentErrors []string, targetsNotFound bool) map[string]*Condition {
+	reason := string(agentgateway.PolicyReasonPending)
+	if targetsNotFound {
+		reason = string(gwv1.PolicyReasonTargetNotFound)
+	}
+	conds := maps.Clone(baseConds)
+	conds[string(agentgateway.PolicyConditionAttached)] = &Condition{
+		Status:  metav1.ConditionFalse,
+		Reason:  reason,
 		Message: strings.Join(attachmentErrors, "\n"),
 	}
 	return conds
@@ -364,10 +859,15 @@ func attachmentErrorConditionMap(baseConds map[string]*Condition, attachmentErro
 func resolvePolicyAncestorRefs(
 	policyNamespace string,
 	targetObject utils.TypedNamespacedName,
+	sectionName *gwv1.SectionName,
 	gatewayTargets []types.NamespacedName,
 	targetExists bool,
 ) ([]gwv1.ParentReference, string) {
 	if !targetExists {
+		// Route section names refer to rules, which must exist on the route for the policy to attach.
+		if sectionName != nil && (targetObject.Kind == wellknown.HTTPRouteKind || targetObject.Kind == wellknown.GRPCRouteKind) {
+			return nil, fmt.Sprintf("Policy is not attached: %s %s/%s rule %s not found", targetObject.Kind, policyNamespace, targetObject.Name, *sectionName)
+		}
 		return nil, fmt.Sprintf("Policy is not attached: %s %s/%s not found", targetObject.Kind, policyNamespace, targetObject.Name)
 	}
 
@@ -395,6 +895,10 @@ func TranslatePolicyToAgw(
 	ctx PolicyCtx,
 	policy *agentgateway.AgentgatewayPolicy,
 ) ([]*api.Policy, error) {
+	if err := validatePolicyCombinations(policy); err != nil {
+		return nil, err
+	}
+
 	agwPolicies := make([]*api.Policy, 0)
 	var errs []error
 
@@ -511,9 +1015,15 @@ func translateTrafficPolicyToAgw(
 		appendPolicy("authorization")(processAuthorizationPolicy(traffic.Authorization, traffic.Phase, basePolicyName, policyName))
 	}
 
+	if ptr.OrDefault(traffic.DefaultDeny, false) {
+		// Appended directly so the policy's inheritance strategy does not replace the
+		// DEFAULT inheritance the deny rule depends on.
+		agwPolicies = append(agwPolicies, processDefaultDenyPolicy(basePolicyName, policyName))
+	}
+
 	// Process RateLimit policies if present
 	if traffic.RateLimit != nil {
-		appendPolicies("rateLimit")(processRateLimitPolicy(ctx, traffic.RateLimit, traffic.Phase, basePolicyName, policyName))
+		appendPolicies("rateLimit")(processRateLimitPolicy(ctx, traffic.RateLimit, traffic.JWTAuthentication != nil, traffic.Phase, basePolicyName, policyName))
 	}
 
 	// Process transformation policies if present
@@ -546,6 +1056,10 @@ func translateTrafficPolicyToAgw(
 		appendPolicy("hostnameRewrite")(processHostnameRewritePolicy(traffic.HostnameRewrite, basePolicyName, policyName), nil)
 	}
 
+	if traffic.HTTPSRedirect != nil {
+		appendPolicy("httpsRedirect")(processHTTPSRedirectPolicy(traffic.HTTPSRedirect, basePolicyName, policyName))
+	}
+
 	if traffic.Timeouts != nil {
 		appendPolicy("timeouts")(processTimeoutPolicy(traffic.Timeouts, basePolicyName, policyName), nil)
 	}
@@ -570,8 +1084,16 @@ func translateTrafficPolicyToAgw(
 		))
 	}
 
-	if traffic.Buffer != nil {
-		appendPolicy("buffer")(processBufferPolicy(traffic.Buffer, basePolicyName, policyName))
+	if traffic.Buffer != nil || traffic.MaxRequestBytes != nil {
+		appendPolicy("buffer")(processBufferPolicy(traffic.Buffer, traffic.MaxRequestBytes, basePolicyName, policyName))
+	}
+
+	if len(traffic.MetadataHeaders) > 0 {
+		appendPolicy("metadataHeaders")(processMetadataHeadersPolicy(traffic, basePolicyName, policyName))
+	}
+
+	if traffic.TCP != nil {
+		appendPolicy("tcp")(processTCPTrafficPolicy(traffic.TCP, basePolicyName, policyName))
 	}
 
 	if traffic.JWTAuthentication != nil {
@@ -608,11 +1130,26 @@ func translateBufferBody(b *agentgateway.BufferBody) *api.TrafficPolicySpec_Buff
 	return nil
 }
 
-func processBufferPolicy(buffer *agentgateway.Buffer, basePolicyName string, policyName types.NamespacedName) (*api.Policy, error) {
-	var errs []error
+// processBufferPolicy translates body buffering. maxRequestBytes is enforced as a fail-closed
+// request buffer, which rejects larger bodies with a 413.
+func processBufferPolicy(buffer *agentgateway.Buffer, maxRequestBytes *agentgateway.ByteSize, basePolicyName string, policyName types.NamespacedName) (*api.Policy, error) {
 	translatedBuffer := &api.TrafficPolicySpec_Buffer{}
-	translatedBuffer.Request = translateBufferBody(buffer.Request)
-	translatedBuffer.Response = translateBufferBody(buffer.Response)
+	if buffer != nil {
+		translatedBuffer.Request = translateBufferBody(buffer.Request)
+		translatedBuffer.Response = translateBufferBody(buffer.Response)
+	}
+	if maxRequestBytes != nil {
+		if translatedBuffer.Request != nil {
+			return nil, categorizedErrorf(PolicyErrorCategoryInvalidCombination, "maxRequestBytes may not be combined with buffer.request")
+		}
+		if v := maxRequestBytes.Value; v == nil || v.Sign() <= 0 || v.Value() > math.MaxUint32 {
+			return nil, categorizedErrorf(PolicyErrorCategoryInvalidValue, "maxRequestBytes must be positive and at most %d bytes, got %s", uint32(math.MaxUint32), v)
+		}
+		translatedBuffer.Request = &api.TrafficPolicySpec_Buffer_BufferBody{
+			MaxBytes:    quantityUint32(maxRequestBytes),
+			FailureMode: api.TrafficPolicySpec_Buffer_FAIL_CLOSED,
+		}
+	}
 
 	bufferPolicy := &api.Policy{
 		Key:  basePolicyName + bufferSuffix,
@@ -630,7 +1167,96 @@ func processBufferPolicy(buffer *agentgateway.Buffer, basePolicyName string, pol
 		"policy", basePolicyName,
 		"agentgateway_policy", bufferPolicy.Name)
 
-	return bufferPolicy, errors.Join(errs...)
+	return bufferPolicy, nil
+}
+
+// processTCPTrafficPolicy translates the TCPRoute idle timeout into a backend policy, which applies
+// to every backend of the targeted route. It uses a dedicated kind rather than BackendTCP, so the
+// keepalive and connect timeout from backend-level policies are not reset to their defaults.
+func processTCPTrafficPolicy(tcp *agentgateway.TrafficTCP, basePolicyName string, policyName types.NamespacedName) (*api.Policy, error) {
+	if tcp.IdleTimeout == nil {
+		return nil, nil
+	}
+	if tcp.IdleTimeout.Duration <= 0 {
+		return nil, categorizedErrorf(PolicyErrorCategoryInvalidValue, "tcp idleTimeout must be positive, got %v", tcp.IdleTimeout.Duration)
+	}
+
+	idleTimeoutPolicy := &api.Policy{
+		Key:  basePolicyName + tcpIdleTimeoutSuffix,
+		Name: TypedResourceFromName(wellknown.AgentgatewayPolicyGVK.Kind, policyName),
+		Kind: &api.Policy_Backend{
+			Backend: &api.BackendPolicySpec{
+				Kind: &api.BackendPolicySpec_TcpIdleTimeout{
+					TcpIdleTimeout: &api.BackendPolicySpec_TCPIdleTimeout{
+						Timeout: durationpb.New(tcp.IdleTimeout.Duration),
+					},
+				},
+			},
+		},
+	}
+
+	logger.Debug("generated TCP idle timeout policy",
+		"policy", basePolicyName,
+		"agentgateway_policy", idleTimeoutPolicy.Name)
+
+	return idleTimeoutPolicy, nil
+}
+
+// routeAttributeExpressions maps route attributes to the CEL expression producing them.
+var routeAttributeExpressions = map[agentgateway.RouteAttribute]string{
+	agentgateway.RouteAttributeName:        "proxy.route.name",
+	agentgateway.RouteAttributeNamespace:   "proxy.route.namespace",
+	agentgateway.RouteAttributeRule:        "proxy.route.rule",
+	agentgateway.RouteAttributeBackendName: "backend.name",
+}
+
+func processMetadataHeadersPolicy(traffic *agentgateway.Traffic, basePolicyName string, policyName types.NamespacedName) (*api.Policy, error) {
+	if traffic.Transformation != nil {
+		return nil, categorizedErrorf(PolicyErrorCategoryInvalidCombination, "metadataHeaders may not be combined with transformation")
+	}
+	transform := &api.TrafficPolicySpec_TransformationPolicy_Transform{}
+	for _, h := range traffic.MetadataHeaders {
+		var expr string
+		switch src := h.ValueFrom; {
+		case src.Claim != nil:
+			if traffic.JWTAuthentication == nil {
+				return nil, categorizedErrorf(PolicyErrorCategoryInvalidCombination, "metadataHeaders %q uses claim %q, which requires jwtAuthentication", h.Name, *src.Claim)
+			}
+			expr = fmt.Sprintf("jwt[%q]", *src.Claim)
+		case src.RouteAttribute != nil:
+			e, ok := routeAttributeExpressions[*src.RouteAttribute]
+			if !ok {
+				return nil, categorizedErrorf(PolicyErrorCategoryInvalidValue, "metadataHeaders %q uses unknown routeAttribute %q", h.Name, *src.RouteAttribute)
+			}
+			expr = e
+		default:
+			return nil, categorizedErrorf(PolicyErrorCategoryInvalidValue, "metadataHeaders %q must set one of claim or routeAttribute", h.Name)
+		}
+		transform.Set = append(transform.Set, &api.TrafficPolicySpec_HeaderTransformation{
+			Name:       string(h.Name),
+			Expression: expr,
+		})
+	}
+
+	metadataPolicy := &api.Policy{
+		Key:  basePolicyName + metadataHeadersSuffix,
+		Name: TypedResourceFromName(wellknown.AgentgatewayPolicyGVK.Kind, policyName),
+		Kind: &api.Policy_Traffic{
+			Traffic: &api.TrafficPolicySpec{
+				Kind: &api.TrafficPolicySpec_Transformation{
+					Transformation: &api.TrafficPolicySpec_TransformationPolicy{
+						Request: transform,
+					},
+				},
+			},
+		},
+	}
+
+	logger.Debug("generated MetadataHeaders policy",
+		"policy", basePolicyName,
+		"agentgateway_policy", metadataPolicy.Name)
+
+	return metadataPolicy, nil
 }
 
 func translatePolicyInheritance(strategy *agentgateway.PolicyStrategy) api.Policy_Inheritance {
@@ -721,13 +1347,13 @@ func processDirectResponseTraffic(_ PolicyCtx, directResponse *agentgateway.Dire
 	}
 	if directResponse.BodyExpression != nil {
 		if !isCEL(*directResponse.BodyExpression) {
-			errs = append(errs, fmt.Errorf("directResponse bodyExpression is not a valid CEL expression: %s", *directResponse.BodyExpression))
+			errs = append(errs, categorizedErrorf(PolicyErrorCategoryInvalidValue, "directResponse bodyExpression is not a valid CEL expression: %s", *directResponse.BodyExpression))
 		}
 		dr.BodyExpression = string(*directResponse.BodyExpression)
 	}
 	for _, header := range directResponse.Headers {
 		if !isCEL(header.Value) {
-			errs = append(errs, fmt.Errorf("directResponse header %q is not a valid CEL expression: %s", header.Name, header.Value))
+			errs = append(errs, categorizedErrorf(PolicyErrorCategoryInvalidValue, "directResponse header %q is not a valid CEL expression: %s", header.Name, header.Value))
 		}
 		dr.Headers = append(dr.Headers, &api.ExpressionHeader{
 			Name:       string(header.Name),
@@ -739,7 +1365,9 @@ func processDirectResponseTraffic(_ PolicyCtx, directResponse *agentgateway.Dire
 }
 
 func processJWTAuthenticationPolicy(ctx PolicyCtx, jwt *agentgateway.JWTAuthentication, policyPhase *agentgateway.PolicyPhase, basePolicyName string, policy types.NamespacedName) (*api.Policy, error) {
-	p := &api.TrafficPolicySpec_JWT{}
+	p := &api.TrafficPolicySpec_JWT{
+		ForwardToken: jwt.ForwardToken,
+	}
 	p.AuthorizationLocation = translateAuthorizationExtractionLocation(jwt.Location)
 
 	switch jwt.Mode {
@@ -755,13 +1383,35 @@ func processJWTAuthenticationPolicy(ctx PolicyCtx, jwt *agentgateway.JWTAuthenti
 	if err := validateExtractionAuthorizationLocation(jwt.Location, "jwtAuthentication location"); err != nil {
 		errs = append(errs, err)
 	}
+	if len(jwt.TokenSources) > 0 {
+		if jwt.Location != nil {
+			errs = append(errs, categorizedErrorf(PolicyErrorCategoryInvalidCombination, "jwtAuthentication location and tokenSources may not both be set"))
+		}
+		for idx, src := range jwt.TokenSources {
+			if err := validateAuthorizationLocation(&src, fmt.Sprintf("jwtAuthentication tokenSources[%d]", idx)); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			p.TokenSources = append(p.TokenSources, translateAuthorizationLocation(&src))
+		}
+	}
 	for idx, pp := range jwt.Providers {
 		jp := &api.TrafficPolicySpec_JWTProvider{
 			Issuer:    pp.Issuer,
 			Audiences: pp.Audiences,
 		}
+		if _, ok := pp.RequiredClaims[""]; ok {
+			errs = append(errs, categorizedErrorf(PolicyErrorCategoryInvalidValue, "jwtAuthentication providers[%d] requiredClaims keys must not be empty", idx))
+			continue
+		}
+		jp.RequiredClaims = pp.RequiredClaims
 		if i := pp.JWKS.Inline; i != nil {
-			jp.JwksSource = &api.TrafficPolicySpec_JWTProvider_Inline{Inline: *i}
+			inline, err := translateInlineJWKS(*i, pp.JWKS.Format)
+			if err != nil {
+				errs = append(errs, categorizedErrorf(PolicyErrorCategoryInvalidValue, "jwtAuthentication providers[%d] jwks inline: %v", idx, err))
+				continue
+			}
+			jp.JwksSource = &api.TrafficPolicySpec_JWTProvider_Inline{Inline: inline}
 			p.Providers = append(p.Providers, jp)
 			continue
 		}
@@ -918,7 +1568,7 @@ func processAPIKeyAuthenticationPolicy(
 		errs = append(errs, err)
 	}
 	if s := ak.SecretRef; s != nil {
-		data, err := ctx.ResolveCredentialRef(*s, policy.Namespace)
+		data, err := ctx.ResolveSecretRef(*s, policy.Namespace)
 		if err != nil {
 			errs = append(errs, err)
 		} else {
@@ -1095,6 +1745,40 @@ func processHostnameRewritePolicy(hnrw *agentgateway.HostnameRewrite, basePolicy
 	return p
 }
 
+func processHTTPSRedirectPolicy(redirect *agentgateway.HTTPSRedirect, basePolicyName string, policy types.NamespacedName) (*api.Policy, error) {
+	r := &api.RequestRedirect{
+		Scheme: "https",
+		Status: http.StatusMovedPermanently,
+	}
+	if redirect.StatusCode != nil {
+		switch *redirect.StatusCode {
+		case http.StatusMovedPermanently, http.StatusFound, http.StatusSeeOther, http.StatusTemporaryRedirect, http.StatusPermanentRedirect:
+			r.Status = uint32(*redirect.StatusCode) // nolint:gosec // G115: checked above to be a redirect status code
+		default:
+			return nil, categorizedErrorf(PolicyErrorCategoryInvalidValue, "httpsRedirect statusCode must be a redirect status code (301, 302, 303, 307, or 308), got %d", *redirect.StatusCode)
+		}
+	}
+	if redirect.Port != nil {
+		r.Port = uint32(*redirect.Port) //nolint:gosec // G115: Gateway API PortNumber is int32 with validation 1-65535, always safe
+	}
+
+	p := &api.Policy{
+		Key:  basePolicyName + httpsRedirectPolicySuffix,
+		Name: TypedResourceFromName(wellknown.AgentgatewayPolicyGVK.Kind, policy),
+		Kind: &api.Policy_Traffic{
+			Traffic: &api.TrafficPolicySpec{
+				Kind: &api.TrafficPolicySpec_RequestRedirect{RequestRedirect: r},
+			},
+		},
+	}
+
+	logger.Debug("generated HTTPSRedirect policy",
+		"policy", basePolicyName,
+		"agentgateway_policy", p.Name)
+
+	return p, nil
+}
+
 func processHeaderModifierPolicy(headerModifier *agentgateway.HeaderModifiers, basePolicyName string, policy types.NamespacedName) []*api.Policy {
 	var policies []*api.Policy
 
@@ -1280,7 +1964,7 @@ func buildExtAuthSpec(
 		var err error
 		be, err = BuildBackendRef(ctx, *extAuth.BackendRef, policy.Namespace)
 		if err != nil {
-			errs = append(errs, fmt.Errorf("failed to build extAuth: %v", err))
+			errs = append(errs, fmt.Errorf("failed to build extAuth: %w", err))
 		}
 	}
 
@@ -1290,7 +1974,7 @@ func buildExtAuthSpec(
 	}
 	if g := extAuth.GRPC; g != nil {
 		metadata := castCELMap(g.RequestMetadata, func(key string, expr agentgateway.CELExpression) {
-			errs = append(errs, fmt.Errorf("extAuth grpc requestMetadata %q is not a valid CEL expression: %s", key, expr))
+			errs = append(errs, categorizedErrorf(PolicyErrorCategoryInvalidValue, "extAuth grpc requestMetadata %q is not a valid CEL expression: %s", key, expr))
 		})
 		p := &api.TrafficPolicySpec_ExternalAuth_GRPCProtocol{
 			Context:  g.ContextExtensions,
@@ -1301,19 +1985,19 @@ func buildExtAuthSpec(
 		}
 	} else if h := extAuth.HTTP; h != nil {
 		path := castCELPtr(h.Path, func(expr agentgateway.CELExpression) {
-			errs = append(errs, fmt.Errorf("extAuth http path is not a valid CEL expression: %s", expr))
+			errs = append(errs, categorizedErrorf(PolicyErrorCategoryInvalidValue, "extAuth http path is not a valid CEL expression: %s", expr))
 		})
 		redirect := castCELPtr(h.Redirect, func(expr agentgateway.CELExpression) {
-			errs = append(errs, fmt.Errorf("extAuth http redirect is not a valid CEL expression: %s", expr))
+			errs = append(errs, categorizedErrorf(PolicyErrorCategoryInvalidValue, "extAuth http redirect is not a valid CEL expression: %s", expr))
 		})
 		body := castCELPtr(h.Body, func(expr agentgateway.CELExpression) {
-			errs = append(errs, fmt.Errorf("extAuth http body is not a valid CEL expression: %s", expr))
+			errs = append(errs, categorizedErrorf(PolicyErrorCategoryInvalidValue, "extAuth http body is not a valid CEL expression: %s", expr))
 		})
 		addRequestHeaders := castCELMap(h.AddRequestHeaders, func(key string, expr agentgateway.CELExpression) {
-			errs = append(errs, fmt.Errorf("extAuth http addRequestHeaders %q is not a valid CEL expression: %s", key, expr))
+			errs = append(errs, categorizedErrorf(PolicyErrorCategoryInvalidValue, "extAuth http addRequestHeaders %q is not a valid CEL expression: %s", key, expr))
 		})
 		metadata := castCELMap(h.ResponseMetadata, func(key string, expr agentgateway.CELExpression) {
-			errs = append(errs, fmt.Errorf("extAuth http responseMetadata %q is not a valid CEL expression: %s", key, expr))
+			errs = append(errs, categorizedErrorf(PolicyErrorCategoryInvalidValue, "extAuth http responseMetadata %q is not a valid CEL expression: %s", key, expr))
 		})
 		p := &api.TrafficPolicySpec_ExternalAuth_HTTPProtocol{
 			Path:                   path,
@@ -1343,10 +2027,10 @@ func buildExtAuthSpec(
 	}
 	if cache := extAuth.Cache; cache != nil {
 		key := castCELSlice(cache.Key, func(expr agentgateway.CELExpression) {
-			errs = append(errs, fmt.Errorf("extAuth cache key is not a valid CEL expression: %s", expr))
+			errs = append(errs, categorizedErrorf(PolicyErrorCategoryInvalidValue, "extAuth cache key is not a valid CEL expression: %s", expr))
 		})
 		ttl := castDurationOrCEL(cache.TTL, func(expr agentgateway.CELExpression) {
-			errs = append(errs, fmt.Errorf("extAuth cache ttl is not a valid CEL expression: %s", expr))
+			errs = append(errs, categorizedErrorf(PolicyErrorCategoryInvalidValue, "extAuth cache ttl is not a valid CEL expression: %s", expr))
 		})
 		spec.Cache = &api.TrafficPolicySpec_ExternalAuth_Cache{
 			Key:        key,
@@ -1372,7 +2056,7 @@ func processExtProcTraffic(
 		var err error
 		be, err = BuildBackendRef(ctx, *extProc.BackendRef, policy.Namespace)
 		if err != nil {
-			errs = append(errs, fmt.Errorf("failed to build extProc: %v", err))
+			errs = append(errs, fmt.Errorf("failed to build extProc: %w", err))
 		}
 	}
 
@@ -1408,16 +2092,16 @@ func processExtProcTraffic(
 	}
 
 	spec.RequestAttributes = castCELMap(extProc.RequestAttributes, func(key string, expr agentgateway.CELExpression) {
-		errs = append(errs, fmt.Errorf("extProc requestAttributes %q is not a valid CEL expression: %s", key, expr))
+		errs = append(errs, categorizedErrorf(PolicyErrorCategoryInvalidValue, "extProc requestAttributes %q is not a valid CEL expression: %s", key, expr))
 	})
 	spec.ResponseAttributes = castCELMap(extProc.ResponseAttributes, func(key string, expr agentgateway.CELExpression) {
-		errs = append(errs, fmt.Errorf("extProc responseAttributes %q is not a valid CEL expression: %s", key, expr))
+		errs = append(errs, categorizedErrorf(PolicyErrorCategoryInvalidValue, "extProc responseAttributes %q is not a valid CEL expression: %s", key, expr))
 	})
 	if len(extProc.MetadataContext) > 0 {
 		spec.MetadataContext = make(map[string]*api.TrafficPolicySpec_ExtProc_NamespacedMetadataContext, len(extProc.MetadataContext))
 		for ns, ctx := range extProc.MetadataContext {
 			context := castCELMap(ctx, func(key string, expr agentgateway.CELExpression) {
-				errs = append(errs, fmt.Errorf("extProc metadataContext %q/%q is not a valid CEL expression: %s", ns, key, expr))
+				errs = append(errs, categorizedErrorf(PolicyErrorCategoryInvalidValue, "extProc metadataContext %q/%q is not a valid CEL expression: %s", ns, key, expr))
 			})
 			spec.MetadataContext[ns] = &api.TrafficPolicySpec_ExtProc_NamespacedMetadataContext{Context: context}
 		}
@@ -1542,7 +2226,7 @@ func processAuthorizationPolicy(
 	var errs []error
 	var allowPolicies, denyPolicies, requirePolicies []string
 	policies := castCELSlice(auth.Policy.MatchExpressions, func(expr agentgateway.CELExpression) {
-		errs = append(errs, fmt.Errorf("authorization matchExpression is not a valid CEL expression: %s", expr))
+		errs = append(errs, categorizedErrorf(PolicyErrorCategoryInvalidValue, "authorization matchExpression is not a valid CEL expression: %s", expr))
 	})
 	if auth.Action == agentgateway.AuthorizationPolicyActionDeny {
 		denyPolicies = append(denyPolicies, policies...)
@@ -1576,26 +2260,56 @@ func processAuthorizationPolicy(
 	return pol, errors.Join(errs...)
 }
 
+// processDefaultDenyPolicy creates an authorization policy with a single allow rule that never
+// matches. Authorization rules from every attachment point are merged, so requests are denied
+// unless an allow rule from another policy, such as one attached to the route, matches.
+// Inheritance is always DEFAULT: an Override strategy would stop the route-level allow rules
+// from being merged in, denying every request instead of only the ones no allow rule matches.
+func processDefaultDenyPolicy(basePolicyName string, policy types.NamespacedName) *api.Policy {
+	return &api.Policy{
+		Key:         basePolicyName + defaultDenyPolicySuffix,
+		Name:        TypedResourceFromName(wellknown.AgentgatewayPolicyGVK.Kind, policy),
+		Inheritance: api.Policy_DEFAULT,
+		Kind: &api.Policy_Traffic{
+			Traffic: &api.TrafficPolicySpec{
+				Kind: &api.TrafficPolicySpec_Authorization{
+					Authorization: &api.TrafficPolicySpec_RBAC{
+						Allow: []string{"false"},
+					},
+				},
+			},
+		},
+	}
+}
+
 func getFrontendPolicyName(trafficPolicyNs, trafficPolicyName string) string {
-	return fmt.Sprintf("frontend/%s/%s", trafficPolicyNs, trafficPolicyName)
+	return "frontend/" + policyKey(trafficPolicyNs, trafficPolicyName)
 }
 
 func getBackendPolicyName(trafficPolicyNs, trafficPolicyName string) string {
-	return fmt.Sprintf("backend/%s/%s", trafficPolicyNs, trafficPolicyName)
+	return "backend/" + policyKey(trafficPolicyNs, trafficPolicyName)
 }
 
 func getTrafficPolicyName(trafficPolicyNs, trafficPolicyName string) string {
-	return fmt.Sprintf("traffic/%s/%s", trafficPolicyNs, trafficPolicyName)
+	return "traffic/" + policyKey(trafficPolicyNs, trafficPolicyName)
 }
 
 // processRateLimitPolicy processes RateLimit configuration and creates corresponding agentgateway policies
 func processRateLimitPolicy(
 	ctx PolicyCtx,
 	rl *agentgateway.RateLimitsOrConditional,
+	jwtAuthentication bool,
 	policyPhase *agentgateway.PolicyPhase,
 	basePolicyName string,
 	policy types.NamespacedName,
 ) ([]*api.Policy, error) {
+	if rl.Tiered != nil {
+		pol, err := processTieredRateLimitPolicy(ctx, rl.Tiered, jwtAuthentication, policyPhase, basePolicyName, policy)
+		if pol == nil {
+			return nil, err
+		}
+		return []*api.Policy{pol}, err
+	}
 	concrete, conditional := rl.ConditionalPolicy()
 	if concrete != nil {
 		return processConcreteRateLimitPolicy(ctx, concrete, policyPhase, basePolicyName, policy)
@@ -1642,6 +2356,36 @@ func processRateLimitPolicy(
 	return agwPolicies, errors.Join(errs...)
 }
 
+// processTieredRateLimitPolicy expands claim-based tiers into conditional local rate limits. Each tier matches
+// on the claim value; the default limits are the unconditional fallback, which also covers requests without the
+// claim since a failed lookup does not match any tier.
+func processTieredRateLimitPolicy(
+	ctx PolicyCtx,
+	tiered *agentgateway.RateLimitTiers,
+	jwtAuthentication bool,
+	policyPhase *agentgateway.PolicyPhase,
+	basePolicyName string,
+	policy types.NamespacedName,
+) (*api.Policy, error) {
+	if !jwtAuthentication {
+		return nil, categorizedErrorf(PolicyErrorCategoryInvalidCombination, "rateLimit.tiered uses claim %q, which requires jwtAuthentication", tiered.Claim)
+	}
+	if len(tiered.Tiers) == 0 {
+		return nil, categorizedErrorf(PolicyErrorCategoryInvalidValue, "rateLimit.tiered requires at least one tier")
+	}
+	entries := make([]agentgateway.ConditionalPolicyEntry[[]agentgateway.LocalRateLimit], 0, len(tiered.Tiers)+1)
+	for _, tier := range tiered.Tiers {
+		entries = append(entries, agentgateway.ConditionalPolicyEntry[[]agentgateway.LocalRateLimit]{
+			Condition: agentgateway.CELExpression(fmt.Sprintf("jwt[%q] == %q", tiered.Claim, tier.Value)),
+			Policy:    tier.Local,
+		})
+	}
+	entries = append(entries, agentgateway.ConditionalPolicyEntry[[]agentgateway.LocalRateLimit]{
+		Policy: tiered.Default,
+	})
+	return processConditionalEntries(entries, processLocalRateLimitTraffic, localRateLimitPolicySuffix, ctx, policyPhase, basePolicyName, policy)
+}
+
 func processConcreteRateLimitPolicy(ctx PolicyCtx, rl *agentgateway.RateLimits, policyPhase *agentgateway.PolicyPhase, basePolicyName string, policy types.NamespacedName) ([]*api.Policy, error) {
 	var agwPolicies []*api.Policy
 	var errs []error
@@ -1716,7 +2460,7 @@ func processGlobalRateLimitTraffic(ctx PolicyCtx, grl *agentgateway.GlobalRateLi
 	var errs []error
 	be, err := BuildBackendRef(ctx, grl.BackendRef, policy.Namespace)
 	if err != nil {
-		errs = append(errs, fmt.Errorf("failed to build global rate limit: %v", err))
+		errs = append(errs, fmt.Errorf("failed to build global rate limit: %w", err))
 	}
 	descriptors := make([]*api.TrafficPolicySpec_RemoteRateLimit_Descriptor, 0, len(grl.Descriptors))
 	for _, d := range grl.Descriptors {
@@ -1767,7 +2511,7 @@ func processRateLimitDescriptor(descriptor agentgateway.RateLimitDescriptor) (*a
 
 	for _, entry := range descriptor.Entries {
 		if !isCEL(entry.Expression) {
-			errs = append(errs, fmt.Errorf("rate limit descriptor entry %q is not a valid CEL expression: %s", entry.Name, entry.Expression))
+			errs = append(errs, categorizedErrorf(PolicyErrorCategoryInvalidValue, "rate limit descriptor entry %q is not a valid CEL expression: %s", entry.Name, entry.Expression))
 		}
 		entries = append(entries, &api.TrafficPolicySpec_RemoteRateLimit_Entry{
 			Key:   entry.Name,
@@ -1782,7 +2526,7 @@ func processRateLimitDescriptor(descriptor agentgateway.RateLimitDescriptor) (*a
 	var cost *string
 	if descriptor.Cost != nil {
 		if !isCEL(*descriptor.Cost) {
-			errs = append(errs, fmt.Errorf("rate limit descriptor cost is not a valid CEL expression: %s", *descriptor.Cost))
+			errs = append(errs, categorizedErrorf(PolicyErrorCategoryInvalidValue, "rate limit descriptor cost is not a valid CEL expression: %s", *descriptor.Cost))
 		}
 		cost = new(string(*descriptor.Cost))
 	}
@@ -1849,7 +2593,7 @@ func checkBackendRefGrant(ctx PolicyCtx, ref gwv1.BackendObjectReference, defaul
 			if sourceGVK.Kind != "" && strings.ContainsAny(strings.ToLower(sourceGVK.Kind[:1]), "aeiou") {
 				article = "an"
 			}
-			return fmt.Errorf("backendRef %v/%v not accessible to %s %s in namespace %q (missing a ReferenceGrant?)", *ref.Namespace, ref.Name, article, sourceGVK.Kind, defaultNS)
+			return categorizedErrorf(PolicyErrorCategoryReferenceNotPermitted, "backendRef %v/%v not accessible to %s %s in namespace %q (missing a ReferenceGrant?)", *ref.Namespace, ref.Name, article, sourceGVK.Kind, defaultNS)
 		}
 	}
 	return nil
@@ -1933,7 +2677,7 @@ func convertTransformSpec(spec *agentgateway.Transform) (*api.TrafficPolicySpec_
 	for _, header := range spec.Set {
 		headerValue := header.Value
 		if !isCEL(headerValue) {
-			errs = append(errs, fmt.Errorf("header value is not a valid CEL expression: %s", headerValue))
+			errs = append(errs, categorizedErrorf(PolicyErrorCategoryInvalidValue, "header value is not a valid CEL expression: %s", headerValue))
 		}
 		if transform == nil {
 			transform = &api.TrafficPolicySpec_TransformationPolicy_Transform{}
@@ -1969,7 +2713,7 @@ func convertTransformSpec(spec *agentgateway.Transform) (*api.TrafficPolicySpec_
 		// Handle body transformation if present
 		bodyValue := *spec.Body
 		if !isCEL(bodyValue) {
-			errs = append(errs, fmt.Errorf("body value is not a valid CEL expression: %s", bodyValue))
+			errs = append(errs, categorizedErrorf(PolicyErrorCategoryInvalidValue, "body value is not a valid CEL expression: %s", bodyValue))
 		}
 		if transform == nil {
 			transform = &api.TrafficPolicySpec_TransformationPolicy_Transform{}
@@ -1986,7 +2730,7 @@ func convertTransformSpec(spec *agentgateway.Transform) (*api.TrafficPolicySpec_
 		transform.Metadata = make(map[string]string, len(spec.Metadata))
 		for key, value := range spec.Metadata {
 			if !isCEL(value) {
-				errs = append(errs, fmt.Errorf("metadata value is not a valid CEL expression: %s", value))
+				errs = append(errs, categorizedErrorf(PolicyErrorCategoryInvalidValue, "metadata value is not a valid CEL expression: %s", value))
 			}
 			transform.Metadata[key] = string(value)
 		}
@@ -1997,8 +2741,8 @@ func convertTransformSpec(spec *agentgateway.Transform) (*api.TrafficPolicySpec_
 
 // Checks if the expression is a valid CEL expression
 func isCEL(expr agentgateway.CELExpression) bool {
-	_, iss := celEnv.Parse(string(expr))
-	return iss.Err() == nil
+	_, _, err := parsedCEL.parse(celEnv, string(expr))
+	return err == nil
 }
 
 func attachmentName(target *api.PolicyTarget) string {
@@ -2007,9 +2751,9 @@ func attachmentName(target *api.PolicyTarget) string {
 	}
 	switch v := target.Kind.(type) {
 	case *api.PolicyTarget_Gateway:
-		b := ":" + v.Gateway.Namespace + "/" + v.Gateway.Name
+		b := ":" + policyKey(v.Gateway.Namespace, v.Gateway.Name)
 		if v.Gateway.Listener != nil {
-			b += "/" + *v.Gateway.Listener
+			b += "/" + policyKeyComponent(*v.Gateway.Listener)
 		}
 		if v.Gateway.Port != nil {
 			// Use a "port=" marker (the "=" is invalid in a SectionName) so a numeric
@@ -2018,27 +2762,27 @@ func attachmentName(target *api.PolicyTarget) string {
 		}
 		return b
 	case *api.PolicyTarget_Route:
-		b := ":" + v.Route.Namespace + "/" + v.Route.Name
+		b := ":" + policyKey(v.Route.Namespace, v.Route.Name)
 		if v.Route.RouteRule != nil {
-			b += "/" + *v.Route.RouteRule
+			b += "/" + policyKeyComponent(*v.Route.RouteRule)
 		}
 		return b
 	case *api.PolicyTarget_Backend:
-		b := ":" + v.Backend.Namespace + "/" + v.Backend.Name
+		b := ":" + policyKey(v.Backend.Namespace, v.Backend.Name)
 		if v.Backend.Section != nil {
-			b += "/" + *v.Backend.Section
+			b += "/" + policyKeyComponent(*v.Backend.Section)
 		}
 		return b
 	case *api.PolicyTarget_Service:
-		b := ":" + v.Service.Namespace + "/" + v.Service.Hostname
+		b := ":" + policyKey(v.Service.Namespace, v.Service.Hostname)
 		if v.Service.Port != nil {
 			b += "/" + strconv.Itoa(int(*v.Service.Port))
 		}
 		return b
 	case *api.PolicyTarget_ListenerSet:
-		b := ":" + v.ListenerSet.Namespace + "/" + v.ListenerSet.Name
+		b := ":" + policyKey(v.ListenerSet.Namespace, v.ListenerSet.Name)
 		if v.ListenerSet.Section != nil {
-			b += "/" + *v.ListenerSet.Section
+			b += "/" + policyKeyComponent(*v.ListenerSet.Section)
 		}
 		return b
 	default:
@@ -2,13 +2,25 @@ package plugins_test
 
 import (
 	"strings"
+	"sync"
 	"testing"
 
+	"istio.io/istio/pkg/kube/krt"
+	"istio.io/istio/pkg/ptr"
 	"istio.io/istio/pkg/slices"
+	"istio.io/istio/pkg/test"
+	"istio.io/istio/pkg/test/util/assert"
+	"istio.io/istio/pkg/test/util/file"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/agentgateway/agentgateway/controller/api/v1alpha1/agentgateway"
 	"github.com/agentgateway/agentgateway/controller/pkg/agentgateway/ir"
 	"github.com/agentgateway/agentgateway/controller/pkg/agentgateway/plugins"
 	"github.com/agentgateway/agentgateway/controller/pkg/agentgateway/testutils"
+	"github.com/agentgateway/agentgateway/controller/pkg/metrics"
+	"github.com/agentgateway/agentgateway/controller/pkg/metrics/metricstest"
 )
 
 func TestTrafficPolicies(t *testing.T) {
@@ -37,3 +49,296 @@ func policyTest(t *testing.T, folder string) {
 		})
 	})
 }
+
+// The CRD only admits one of targetRefs or targetSelectors, but objects that bypass validation
+// are still translated; their target set is the union of both.
+func TestPolicyWithTargetRefsAndTargetSelectors(t *testing.T) {
+	ctx := testutils.BuildMockPolicyContext(t, []any{
+		file.AsStringOrFail(t, "testdata/trafficpolicy/_defaults.yaml"),
+		`apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: labeled-route
+  namespace: default
+  labels:
+    app: api
+spec:
+  parentRefs:
+    - name: test
+  rules:
+    - backendRefs:
+        - name: reviews
+          port: 8080
+---
+apiVersion: agentgateway.dev/v1alpha1
+kind: AgentgatewayPolicy
+metadata:
+  name: agw
+  namespace: default
+spec:
+  targetRefs:
+  - kind: HTTPRoute
+    group: gateway.networking.k8s.io
+    name: labeled-route
+  - kind: HTTPRoute
+    group: gateway.networking.k8s.io
+    name: test
+  targetSelectors:
+  - kind: HTTPRoute
+    group: gateway.networking.k8s.io
+    matchLabels:
+      app: api
+  traffic:
+    timeouts:
+      request: 5s`,
+	})
+	policy := testutils.GetTestResource(t, ctx.Collections.AgentgatewayPolicies)
+
+	status, policies := plugins.TranslateAgentgatewayPolicy(ctx.Krt, policy, ctx.Collections, ctx.References, ctx.Grants, ctx.Resolver, ctx.JWKSLookup, nil)
+
+	// labeled-route is both referenced and selected, but is only translated once.
+	keys := slices.Map(policies, func(p plugins.AgwPolicy) string { return p.Policy.Key })
+	slices.Sort(keys)
+	assert.Equal(t, keys, []string{
+		"traffic/default/agw:timeout:default/labeled-route",
+		"traffic/default/agw:timeout:default/test",
+	})
+
+	assert.Equal(t, len(status.Ancestors), 1)
+	accepted := meta.FindStatusCondition(status.Ancestors[0].Conditions, string(agentgateway.PolicyConditionAccepted))
+	assert.Equal(t, accepted.Reason, string(agentgateway.PolicyReasonPartiallyValid))
+	assert.Equal(t, accepted.Message, "targetRef HTTPRoute default/test is not matched by targetSelectors of the same kind; "+
+		"the policy applies to the union of targetRefs and targetSelectors")
+	attached := meta.FindStatusCondition(status.Ancestors[0].Conditions, string(agentgateway.PolicyConditionAttached))
+	assert.Equal(t, attached.Message, "Attached to all targets (targetSelectors resolved 1 target)")
+}
+
+func TestDisabledPolicy(t *testing.T) {
+	ctx := testutils.BuildMockPolicyContext(t, []any{
+		file.AsStringOrFail(t, "testdata/trafficpolicy/_defaults.yaml"),
+		`apiVersion: agentgateway.dev/v1alpha1
+kind: AgentgatewayPolicy
+metadata:
+  name: agw
+  namespace: default
+spec:
+  enabled: false
+  targetRefs:
+  - kind: HTTPRoute
+    group: gateway.networking.k8s.io
+    name: test
+  traffic:
+    timeouts:
+      request: 5s`,
+	})
+	policy := testutils.GetTestResource(t, ctx.Collections.AgentgatewayPolicies)
+
+	status, policies := plugins.TranslateAgentgatewayPolicy(ctx.Krt, policy, ctx.Collections, ctx.References, ctx.Grants, ctx.Resolver, ctx.JWKSLookup, nil)
+	assert.Equal(t, len(policies), 0)
+	assert.Equal(t, len(status.Ancestors), 1)
+	for _, conditionType := range []agentgateway.PolicyConditionType{
+		agentgateway.PolicyConditionAccepted,
+		agentgateway.PolicyConditionAttached,
+		agentgateway.PolicyConditionProgrammed,
+	} {
+		cond := meta.FindStatusCondition(status.Ancestors[0].Conditions, string(conditionType))
+		assert.Equal(t, cond.Status, metav1.ConditionFalse)
+		assert.Equal(t, cond.Reason, string(agentgateway.PolicyReasonDisabled))
+	}
+
+	// Re-enabling the policy, starting from the status written while it was disabled, restores its output.
+	enabled := policy.DeepCopy()
+	enabled.Spec.Enabled = ptr.Of(true)
+	enabled.Status = *status
+	status, policies = plugins.TranslateAgentgatewayPolicy(ctx.Krt, enabled, ctx.Collections, ctx.References, ctx.Grants, ctx.Resolver, ctx.JWKSLookup, nil)
+	keys := slices.Map(policies, func(p plugins.AgwPolicy) string { return p.Policy.Key })
+	assert.Equal(t, keys, []string{"traffic/default/agw:timeout:default/test"})
+	assert.Equal(t, len(status.Ancestors), 1)
+	for _, conditionType := range []agentgateway.PolicyConditionType{
+		agentgateway.PolicyConditionAccepted,
+		agentgateway.PolicyConditionAttached,
+		agentgateway.PolicyConditionProgrammed,
+	} {
+		cond := meta.FindStatusCondition(status.Ancestors[0].Conditions, string(conditionType))
+		assert.Equal(t, cond.Status, metav1.ConditionTrue)
+	}
+}
+
+// A policy rejected for only one of its targets is counted as rejected, with the reason reported on
+// that target's ancestor.
+func TestPolicyRejectedForOneTargetIsCounted(t *testing.T) {
+	ctx := testutils.BuildMockPolicyContext(t, []any{
+		file.AsStringOrFail(t, "testdata/trafficpolicy/_defaults.yaml"),
+		`apiVersion: gateway.networking.k8s.io/v1
+kind: Gateway
+metadata:
+  name: tcp
+  namespace: default
+spec:
+  gatewayClassName: agentgateway
+  listeners:
+    - name: tcp
+      protocol: TCP
+      port: 9000
+---
+apiVersion: gateway.networking.k8s.io/v1
+kind: TCPRoute
+metadata:
+  name: tcp
+  namespace: default
+spec:
+  parentRefs:
+    - name: tcp
+  rules:
+    - backendRefs:
+        - name: reviews
+          port: 8080
+---
+apiVersion: agentgateway.dev/v1alpha1
+kind: AgentgatewayPolicy
+metadata:
+  name: agw
+  namespace: default
+spec:
+  targetRefs:
+  - kind: TCPRoute
+    group: gateway.networking.k8s.io
+    name: tcp
+  - kind: HTTPRoute
+    group: gateway.networking.k8s.io
+    name: test
+  traffic:
+    tcp:
+      idleTimeout: 5m`,
+	})
+	policy := testutils.GetTestResource(t, ctx.Collections.AgentgatewayPolicies)
+
+	labels := []metrics.Label{
+		{Name: "kind", Value: "AgentgatewayPolicy"},
+		{Name: "reason", Value: string(agentgateway.PolicyReasonInvalid)},
+	}
+	rejected := func() float64 {
+		value := 0.0
+		gathered := metricstest.MustGatherMetrics(t)
+		if gathered.MetricLength("agentgateway_policy_rejected_total") == 0 {
+			return value
+		}
+		gathered.AssertMetricsInclude("agentgateway_policy_rejected_total", []metricstest.ExpectMetric{
+			&metricstest.ExpectedMetricValueTest{Labels: labels, Test: func(v float64) bool {
+				value = v
+				return true
+			}},
+		})
+		return value
+	}
+	before := rejected()
+
+	status, policies := plugins.TranslateAgentgatewayPolicy(ctx.Krt, policy, ctx.Collections, ctx.References, ctx.Grants, ctx.Resolver, ctx.JWKSLookup, nil)
+	keys := slices.Map(policies, func(p plugins.AgwPolicy) string { return p.Policy.Key })
+	assert.Equal(t, keys, []string{"traffic/default/agw:tcp-idle-timeout:default/tcp"})
+	assert.Equal(t, len(status.Ancestors), 2)
+	assert.Equal(t, rejected(), before+1)
+}
+
+// Translation only depends on the Secrets a policy references, by name or by label selector, so
+// changing any other Secret does not re-translate the policy.
+func TestPolicyRetranslatesOnlyForReferencedSecrets(t *testing.T) {
+	ctx := testutils.BuildMockPolicyContext(t, []any{
+		file.AsStringOrFail(t, "testdata/trafficpolicy/_defaults.yaml"),
+		`apiVersion: agentgateway.dev/v1alpha1
+kind: AgentgatewayPolicy
+metadata:
+  name: by-name
+  namespace: default
+spec:
+  targetRefs:
+  - kind: Gateway
+    group: gateway.networking.k8s.io
+    name: test
+  traffic:
+    apiKeyAuthentication:
+      mode: Strict
+      secretRef:
+        name: api-keys
+---
+apiVersion: agentgateway.dev/v1alpha1
+kind: AgentgatewayPolicy
+metadata:
+  name: by-selector
+  namespace: default
+spec:
+  targetRefs:
+  - kind: Gateway
+    group: gateway.networking.k8s.io
+    name: test
+  traffic:
+    apiKeyAuthentication:
+      mode: Strict
+      secretSelector:
+        matchLabels:
+          app: api`,
+		apiKeySecret("api-keys", nil, "v1"),
+		apiKeySecret("selected", map[string]string{"app": "api"}, "v1"),
+		apiKeySecret("unrelated", map[string]string{"app": "other"}, "v1"),
+	})
+	credentialResolver := plugins.DefaultCredentialResolverFactory(ctx.Collections)
+	stop := test.NewStop(t)
+
+	var mu sync.Mutex
+	translations := map[string]int{}
+	translated := krt.NewManyCollection(ctx.Collections.AgentgatewayPolicies, func(krtctx krt.HandlerContext, policy *agentgateway.AgentgatewayPolicy) []plugins.AgwPolicy {
+		mu.Lock()
+		translations[policy.Name]++
+		mu.Unlock()
+		_, policies := plugins.TranslateAgentgatewayPolicy(krtctx, policy, ctx.Collections, ctx.References, ctx.Grants, ctx.Resolver, ctx.JWKSLookup, credentialResolver)
+		return policies
+	}, krt.WithStop(stop))
+	translated.WaitUntilSynced(stop)
+	count := func(name string) int {
+		mu.Lock()
+		defer mu.Unlock()
+		return translations[name]
+	}
+	// apiKeys returns the keys currently translated for a policy.
+	apiKeys := func(name string) func() []string {
+		return func() []string {
+			var keys []string
+			for _, p := range translated.List() {
+				if p.Policy.GetName().GetName() == name {
+					for _, u := range p.Policy.GetTraffic().GetApiKeyAuth().GetApiKeys() {
+						keys = append(keys, u.Key)
+					}
+				}
+			}
+			slices.Sort(keys)
+			return keys
+		}
+	}
+
+	secrets := ctx.Collections.Secrets.(krt.StaticCollection[*corev1.Secret])
+	// krt replays the existing Secrets when a dependency is first registered. Wait for a change to a
+	// referenced Secret to be observed, so that the replay has been handled before counting.
+	secrets.UpdateObject(apiKeySecret("api-keys", nil, "v2"))
+	secrets.UpdateObject(apiKeySecret("selected", map[string]string{"app": "api"}, "v2"))
+	assert.EventuallyEqual(t, apiKeys("by-name"), []string{"v2"})
+	assert.EventuallyEqual(t, apiKeys("by-selector"), []string{"v2"})
+	byName, bySelector := count("by-name"), count("by-selector")
+
+	secrets.UpdateObject(apiKeySecret("unrelated", map[string]string{"app": "other"}, "v2"))
+	secrets.UpdateObject(apiKeySecret("unlabeled", nil, "v2"))
+	// Events are handled in order, so once these changes are observed the unrelated changes have
+	// been handled too.
+	secrets.UpdateObject(apiKeySecret("api-keys", nil, "v3"))
+	secrets.UpdateObject(apiKeySecret("selected", map[string]string{"app": "api"}, "v3"))
+	assert.EventuallyEqual(t, apiKeys("by-name"), []string{"v3"})
+	assert.EventuallyEqual(t, apiKeys("by-selector"), []string{"v3"})
+	assert.Equal(t, count("by-name"), byName+1)
+	assert.Equal(t, count("by-selector"), bySelector+1)
+}
+
+func apiKeySecret(name string, labels map[string]string, key string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: labels},
+		Data:       map[string][]byte{"key": []byte(key)},
+	}
+}
@@ -191,6 +191,9 @@ func bestMatchingAgentgatewayPolicy(
 		bestRank sectionMatchRank
 	)
 	for _, candidate := range candidates {
+		if !candidate.Spec.IsEnabled() {
+			continue
+		}
 		rank := bestMatchingPolicyTargetRank(candidate.Spec.TargetRefs, group, kind, name, matcher)
 		if rank == sectionNoMatch {
 			continue
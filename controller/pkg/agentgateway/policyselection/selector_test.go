@@ -75,6 +75,17 @@ func TestBestMatchingAgentgatewayPolicy(t *testing.T) {
 		matcher,
 	)
 	require.Same(t, olderExact, selected)
+
+	disabledExact := olderExact.DeepCopy()
+	disabledExact.Spec.Enabled = ptr.Of(false)
+	selected = bestMatchingAgentgatewayPolicy(
+		[]*agentgateway.AgentgatewayPolicy{disabledExact, older},
+		"",
+		"Service",
+		"oauth2",
+		matcher,
+	)
+	require.Same(t, older, selected)
 }
 
 func TestBestMatchingBackendTLSPolicy(t *testing.T) {
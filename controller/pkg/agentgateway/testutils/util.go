@@ -201,25 +201,7 @@ func agwPluginFactory(agwCollections *plugins.AgwCollections, resolver remotehtt
 }
 
 func BuildMockPolicyContext(t test.Failer, inputs []any) plugins.PolicyCtx {
-	collections := BuildMockCollection(t, inputs)
-	resolver := BuildRemoteHTTPResolver(collections)
-	referenceTypes := plugins.DefaultReferenceTypes(collections)
-	grants := translator.BuildReferenceGrants(translator.ReferenceGrantsCollection(
-		collections.ReferenceGrants,
-		referenceTypes.KnownFromReferences,
-		referenceTypes.KnownToReferences,
-		collections.KrtOpts,
-	))
-	return plugins.PolicyCtx{
-		Krt:         krt.TestingDummyContext{},
-		Collections: collections,
-		Grants:      grants,
-		References:  plugins.BuildReferenceIndex(nil, nil, plugins.DefaultReferenceTypes(collections)),
-		Resolver:    resolver,
-		JWKSLookup:  jwks.NewLookup(jwks.NewPersistedEntriesFromCollection(collections.ConfigMaps, jwks.DefaultJwksStorePrefix, collections.SystemNamespace, collections.KrtOpts.ToOptions("jwks/PersistedEntries")...), jwks.NewResolver(resolver)),
-
-		CredentialResolver: plugins.DefaultCredentialResolverFactory(collections),
-	}
+	return translator.NewStaticPolicyContext(BuildMockCollection(t, inputs))
 }
 
 func BuildMockCollection(t test.Failer, inputs []any) *plugins.AgwCollections {
@@ -0,0 +1,210 @@
+package translator
+
+import (
+	"fmt"
+
+	networkingclient "istio.io/client-go/pkg/apis/networking/v1"
+	"istio.io/istio/pkg/kube/krt"
+	"istio.io/istio/pkg/ptr"
+	corev1 "k8s.io/api/core/v1"
+	discovery "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/types"
+	inf "sigs.k8s.io/gateway-api-inference-extension/api/v1"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/agentgateway/agentgateway/controller/api/v1alpha1/agentgateway"
+	"github.com/agentgateway/agentgateway/controller/pkg/agentgateway/jwks"
+	"github.com/agentgateway/agentgateway/controller/pkg/agentgateway/plugins"
+	"github.com/agentgateway/agentgateway/controller/pkg/agentgateway/policyselection"
+	"github.com/agentgateway/agentgateway/controller/pkg/agentgateway/remotehttp"
+	"github.com/agentgateway/agentgateway/controller/pkg/agentgateway/utils"
+	"github.com/agentgateway/agentgateway/controller/pkg/pluginsdk/krtutil"
+	"github.com/agentgateway/agentgateway/controller/pkg/wellknown"
+)
+
+// NewStaticCollections builds AgwCollections holding a fixed set of decoded objects, such as
+// Services, Backends, Gateways, routes and other AgentgatewayPolicies. It lets tooling translate
+// policies without a Kubernetes client. Collections stop when stop is closed.
+func NewStaticCollections(stop <-chan struct{}, objects ...any) (*plugins.AgwCollections, error) {
+	remaining := objects
+	col := &plugins.AgwCollections{
+		Namespaces:           staticCollection[*corev1.Namespace](stop, &remaining),
+		Nodes:                staticCollection[*corev1.Node](stop, &remaining),
+		Pods:                 staticCollection[*corev1.Pod](stop, &remaining),
+		Services:             staticCollection[*corev1.Service](stop, &remaining),
+		Secrets:              staticCollection[*corev1.Secret](stop, &remaining),
+		ConfigMaps:           staticCollection[*corev1.ConfigMap](stop, &remaining),
+		EndpointSlices:       staticCollection[*discovery.EndpointSlice](stop, &remaining),
+		WorkloadEntries:      staticCollection[*networkingclient.WorkloadEntry](stop, &remaining),
+		ServiceEntries:       staticCollection[*networkingclient.ServiceEntry](stop, &remaining),
+		GatewayClasses:       staticCollection[*gwv1.GatewayClass](stop, &remaining),
+		Gateways:             staticCollection[*gwv1.Gateway](stop, &remaining),
+		HTTPRoutes:           staticCollection[*gwv1.HTTPRoute](stop, &remaining),
+		GRPCRoutes:           staticCollection[*gwv1.GRPCRoute](stop, &remaining),
+		TCPRoutes:            staticCollection[*gwv1.TCPRoute](stop, &remaining),
+		TLSRoutes:            staticCollection[*gwv1.TLSRoute](stop, &remaining),
+		ReferenceGrants:      staticCollection[*gwv1b1.ReferenceGrant](stop, &remaining),
+		BackendTLSPolicies:   staticCollection[*gwv1.BackendTLSPolicy](stop, &remaining),
+		ListenerSets:         staticCollection[*gwv1.ListenerSet](stop, &remaining),
+		InferencePools:       staticCollection[*inf.InferencePool](stop, &remaining),
+		Backends:             staticCollection[*agentgateway.AgentgatewayBackend](stop, &remaining),
+		AgentgatewayPolicies: staticCollection[*agentgateway.AgentgatewayPolicy](stop, &remaining),
+		ControllerName:       wellknown.DefaultAgwControllerName,
+		SystemNamespace:      "agentgateway-system",
+		IstioNamespace:       "istio-system",
+		IstioClusterId:       "Kubernetes",
+		KrtOpts:              krtutil.NewKrtOptions(stop, nil),
+	}
+	if len(remaining) > 0 {
+		unsupported := make([]string, 0, len(remaining))
+		for _, o := range remaining {
+			unsupported = append(unsupported, fmt.Sprintf("%T", o))
+		}
+		return nil, fmt.Errorf("unsupported objects: %v", unsupported)
+	}
+	col.SetupIndexes()
+	return col, nil
+}
+
+func staticCollection[T any](stop <-chan struct{}, objects *[]any) krt.Collection[T] {
+	var matched []T
+	var unmatched []any
+	for _, o := range *objects {
+		if t, ok := o.(T); ok {
+			matched = append(matched, t)
+		} else {
+			unmatched = append(unmatched, o)
+		}
+	}
+	*objects = unmatched
+	return krt.NewStaticCollection(nil, matched, krt.WithStop(stop))
+}
+
+// NewStaticPolicyContext builds the PolicyCtx used to translate policies against collections.
+// Routes are treated as attached to every Gateway in their parentRefs, and their backends as
+// reachable from those Gateways, without checking that the Gateway accepts the route.
+func NewStaticPolicyContext(collections *plugins.AgwCollections) plugins.PolicyCtx {
+	stop := collections.KrtOpts.Stop
+	resolver := remotehttp.NewResolver(remotehttp.Inputs{
+		ConfigMaps:     collections.ConfigMaps,
+		Services:       collections.Services,
+		Backends:       collections.Backends,
+		PolicySelector: policyselection.NewSelector(collections.AgentgatewayPolicies, collections.BackendTLSPolicies),
+	})
+	referenceTypes := plugins.DefaultReferenceTypes(collections)
+	grantsCollection := ReferenceGrantsCollection(
+		collections.ReferenceGrants,
+		referenceTypes.KnownFromReferences,
+		referenceTypes.KnownToReferences,
+		collections.KrtOpts,
+	)
+
+	attachments, ancestors := staticRouteAttachments(collections)
+	attachmentsIndex := krt.NewIndex(krt.NewStaticCollection(nil, attachments, krt.WithStop(stop)), "from", func(o *plugins.RouteAttachment) []utils.TypedNamespacedName {
+		return []utils.TypedNamespacedName{o.From}
+	}).AsCollection(krt.WithStop(stop), utils.TypedNamespacedNameIndexCollectionFunc)
+	ancestorsIndex := krt.NewIndex(krt.NewStaticCollection(nil, ancestors, krt.WithStop(stop)), "ancestors", func(o *utils.AncestorBackend) []utils.TypedNamespacedName {
+		return []utils.TypedNamespacedName{o.Backend}
+	}).AsCollection(krt.WithStop(stop), utils.TypedNamespacedNameIndexCollectionFunc)
+
+	grantsCollection.WaitUntilSynced(stop)
+	return plugins.PolicyCtx{
+		Krt:         krt.TestingDummyContext{},
+		Collections: collections,
+		Grants:      BuildReferenceGrants(grantsCollection),
+		References:  plugins.BuildReferenceIndex(ancestorsIndex, attachmentsIndex, referenceTypes),
+		Resolver:    resolver,
+		JWKSLookup: jwks.NewLookup(
+			jwks.NewPersistedEntriesFromCollection(collections.ConfigMaps, jwks.DefaultJwksStorePrefix, collections.SystemNamespace, collections.KrtOpts.ToOptions("jwks/PersistedEntries")...),
+			jwks.NewResolver(resolver),
+		),
+		CredentialResolver: plugins.DefaultCredentialResolverFactory(collections),
+	}
+}
+
+// staticRouteAttachments derives route to Gateway attachments, and the backends reachable from
+// each Gateway, from the parentRefs and backendRefs of the routes in collections.
+func staticRouteAttachments(collections *plugins.AgwCollections) ([]*plugins.RouteAttachment, []*utils.AncestorBackend) {
+	var attachments []*plugins.RouteAttachment
+	var ancestors []*utils.AncestorBackend
+	add := func(kind, namespace, name string, parentRefs []gwv1.ParentReference, backendRefs []gwv1.BackendObjectReference) {
+		route := utils.TypedNamespacedName{Kind: kind, NamespacedName: types.NamespacedName{Namespace: namespace, Name: name}}
+		for _, parent := range parentRefs {
+			if ptr.OrDefault(parent.Group, gwv1.GroupName) != gwv1.GroupName || ptr.OrDefault(parent.Kind, wellknown.GatewayKind) != wellknown.GatewayKind {
+				continue
+			}
+			gateway := types.NamespacedName{Namespace: string(ptr.OrDefault(parent.Namespace, gwv1.Namespace(namespace))), Name: string(parent.Name)}
+			attachments = append(attachments, &plugins.RouteAttachment{
+				From:         route,
+				To:           utils.TypedNamespacedName{Kind: wellknown.GatewayKind, NamespacedName: gateway},
+				ListenerName: string(ptr.OrDefault(parent.SectionName, "")),
+				Gateway:      gateway,
+			})
+			for _, ref := range backendRefs {
+				ancestors = append(ancestors, &utils.AncestorBackend{
+					Gateway: gateway,
+					Backend: utils.TypedNamespacedName{
+						Kind:           string(ptr.OrDefault(ref.Kind, wellknown.ServiceKind)),
+						NamespacedName: types.NamespacedName{Namespace: string(ptr.OrDefault(ref.Namespace, gwv1.Namespace(namespace))), Name: string(ref.Name)},
+					},
+					Source: route,
+				})
+			}
+		}
+	}
+	for _, r := range collections.HTTPRoutes.List() {
+		var refs []gwv1.BackendObjectReference
+		for _, rule := range r.Spec.Rules {
+			for _, ref := range rule.BackendRefs {
+				refs = append(refs, ref.BackendObjectReference)
+			}
+		}
+		add(wellknown.HTTPRouteKind, r.Namespace, r.Name, r.Spec.ParentRefs, refs)
+	}
+	for _, r := range collections.GRPCRoutes.List() {
+		var refs []gwv1.BackendObjectReference
+		for _, rule := range r.Spec.Rules {
+			for _, ref := range rule.BackendRefs {
+				refs = append(refs, ref.BackendObjectReference)
+			}
+		}
+		add(wellknown.GRPCRouteKind, r.Namespace, r.Name, r.Spec.ParentRefs, refs)
+	}
+	for _, r := range collections.TCPRoutes.List() {
+		var refs []gwv1.BackendObjectReference
+		for _, rule := range r.Spec.Rules {
+			for _, ref := range rule.BackendRefs {
+				refs = append(refs, ref.BackendObjectReference)
+			}
+		}
+		add(wellknown.TCPRouteKind, r.Namespace, r.Name, r.Spec.ParentRefs, refs)
+	}
+	for _, r := range collections.TLSRoutes.List() {
+		var refs []gwv1.BackendObjectReference
+		for _, rule := range r.Spec.Rules {
+			for _, ref := range rule.BackendRefs {
+				refs = append(refs, ref.BackendObjectReference)
+			}
+		}
+		add(wellknown.TLSRouteKind, r.Namespace, r.Name, r.Spec.ParentRefs, refs)
+	}
+	return attachments, ancestors
+}
+
+// TranslatePolicy translates a single AgentgatewayPolicy against the objects in ctx and returns
+// the status it would be reported with, alongside the policies sent to each Gateway. It performs
+// the same translation as the controller, so tooling such as linters can check policies read from
+// files.
+func TranslatePolicy(ctx plugins.PolicyCtx, policy *agentgateway.AgentgatewayPolicy) (*gwv1.PolicyStatus, []plugins.AgwPolicy) {
+	return plugins.TranslateAgentgatewayPolicy(
+		ctx.Krt,
+		policy,
+		ctx.Collections,
+		ctx.References,
+		ctx.Grants,
+		ctx.Resolver,
+		ctx.JWKSLookup,
+		ctx.CredentialResolver,
+	)
+}
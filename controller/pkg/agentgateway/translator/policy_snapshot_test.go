@@ -0,0 +1,119 @@
+package translator_test
+
+import (
+	"testing"
+
+	"istio.io/istio/pkg/test"
+	"istio.io/istio/pkg/test/util/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/agentgateway/agentgateway/controller/api/v1alpha1/agentgateway"
+	"github.com/agentgateway/agentgateway/controller/pkg/agentgateway/translator"
+)
+
+const snapshotPolicyTemplate = `
+apiVersion: agentgateway.dev/v1alpha1
+kind: AgentgatewayPolicy
+metadata:
+  namespace: default
+spec:
+  targetRefs:
+  - group: gateway.networking.k8s.io
+    kind: HTTPRoute
+    name: route
+  backend:
+    tls:
+      sni: reviews.example.com
+`
+
+func decodeSnapshotObject[T any](t *testing.T, data string) *T {
+	t.Helper()
+	obj := new(T)
+	if err := yaml.Unmarshal([]byte(data), obj); err != nil {
+		t.Fatalf("failed to decode object: %v", err)
+	}
+	return obj
+}
+
+func snapshotPolicy(t *testing.T, name string, created metav1.Time) *agentgateway.AgentgatewayPolicy {
+	t.Helper()
+	policy := decodeSnapshotObject[agentgateway.AgentgatewayPolicy](t, snapshotPolicyTemplate)
+	policy.Name = name
+	policy.CreationTimestamp = created
+	return policy
+}
+
+func TestTranslatePolicy(t *testing.T) {
+	gateway := decodeSnapshotObject[gwv1.Gateway](t, `
+metadata:
+  name: gw
+  namespace: default
+spec:
+  gatewayClassName: agentgateway
+  listeners:
+  - name: http
+    protocol: HTTP
+    port: 8080
+`)
+	route := decodeSnapshotObject[gwv1.HTTPRoute](t, `
+metadata:
+  name: route
+  namespace: default
+spec:
+  parentRefs:
+  - name: gw
+  rules:
+  - backendRefs:
+    - name: reviews
+      port: 8080
+`)
+	service := decodeSnapshotObject[corev1.Service](t, `
+metadata:
+  name: reviews
+  namespace: default
+spec:
+  ports:
+  - port: 8080
+`)
+	older := snapshotPolicy(t, "older", metav1.Unix(1000, 0))
+	newer := snapshotPolicy(t, "newer", metav1.Unix(2000, 0))
+
+	collections, err := translator.NewStaticCollections(test.NewStop(t), gateway, route, service, older, newer)
+	assert.NoError(t, err)
+	ctx := translator.NewStaticPolicyContext(collections)
+	gatewayRef := types.NamespacedName{Namespace: "default", Name: "gw"}
+
+	t.Run("valid", func(t *testing.T) {
+		status, policies := translator.TranslatePolicy(ctx, older)
+		assert.Equal(t, len(status.Ancestors), 1)
+		assert.Equal(t, string(status.Ancestors[0].AncestorRef.Name), "gw")
+		accepted := meta.FindStatusCondition(status.Ancestors[0].Conditions, string(agentgateway.PolicyConditionAccepted))
+		assert.Equal(t, accepted.Status, metav1.ConditionTrue)
+		assert.Equal(t, accepted.Reason, string(agentgateway.PolicyReasonValid))
+		if len(policies) == 0 {
+			t.Fatal("expected translated policies")
+		}
+		for _, p := range policies {
+			assert.Equal(t, *p.Gateway, gatewayRef)
+		}
+	})
+
+	t.Run("conflict", func(t *testing.T) {
+		status, policies := translator.TranslatePolicy(ctx, newer)
+		assert.Equal(t, len(status.Ancestors), 1)
+		accepted := meta.FindStatusCondition(status.Ancestors[0].Conditions, string(agentgateway.PolicyConditionAccepted))
+		assert.Equal(t, accepted.Status, metav1.ConditionFalse)
+		assert.Equal(t, accepted.Reason, string(gwv1.PolicyReasonConflicted))
+		assert.Equal(t, len(policies), 0)
+	})
+}
+
+func TestNewStaticCollectionsRejectsUnsupportedObjects(t *testing.T) {
+	_, err := translator.NewStaticCollections(test.NewStop(t), &corev1.PersistentVolume{})
+	assert.Error(t, err)
+}
@@ -52,8 +52,9 @@ type virtualkeysCandidate struct {
 // virtualkeysAPIKeyAuth is the apiKeyAuthentication subset this migration duck-types against.
 type virtualkeysAPIKeyAuth struct {
 	SecretRef *struct {
-		Name string `json:"name"`
-		Kind string `json:"kind,omitempty"`
+		Name      string `json:"name"`
+		Kind      string `json:"kind,omitempty"`
+		Namespace string `json:"namespace,omitempty"`
 	} `json:"secretRef,omitempty"`
 	SecretSelector *struct {
 		MatchLabels map[string]string `json:"matchLabels"`
@@ -187,6 +188,10 @@ func virtualkeysMigratePolicy(ctx context.Context, out, status io.Writer, kubeCl
 		if ak.SecretRef.Kind != "" && ak.SecretRef.Kind != "Secret" {
 			return false, nil, nil
 		}
+		if ak.SecretRef.Namespace != "" && ak.SecretRef.Namespace != policy.GetNamespace() {
+			// The ConfigMap is written next to the policy; leave cross-namespace Secrets alone.
+			return false, nil, nil
+		}
 		secretNames = []string{ak.SecretRef.Name}
 	case ak.SecretSelector != nil:
 		list, err := kubeClient.Kube().CoreV1().Secrets(policy.GetNamespace()).List(ctx, metav1.ListOptions{
@@ -80,7 +80,7 @@ func virtualkeysFixturePolicy(name, namespace string) *agentgateway.Agentgateway
 		Spec: agentgateway.AgentgatewayPolicySpec{
 			Traffic: &agentgateway.Traffic{
 				APIKeyAuthentication: &agentgateway.APIKeyAuthentication{
-					SecretRef: &agentgateway.LocalSecretObjectRef{Name: gwv1.ObjectName("api-key")},
+					SecretRef: &agentgateway.SecretObjectRef{Name: gwv1.ObjectName("api-key")},
 				},
 			},
 		},
@@ -14,11 +14,13 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
+	"github.com/agentgateway/agentgateway/controller/api/v1alpha1/agentgateway"
 	"github.com/agentgateway/agentgateway/controller/pkg/apiclient"
 	"github.com/agentgateway/agentgateway/controller/pkg/deployer"
 	"github.com/agentgateway/agentgateway/controller/pkg/reports"
@@ -36,6 +38,7 @@ type gatewayClassReconciler struct {
 	classInfo             map[string]*deployer.GatewayClassInfo
 	defaultControllerName string
 	gwClassClient         kclient.Client[*gwv1.GatewayClass]
+	agwParamClient        kclient.Client[*agentgateway.AgentgatewayParameters]
 	client                apiclient.Client
 	queue                 controllers.Queue
 }
@@ -49,6 +52,7 @@ func newGatewayClassReconciler(
 		defaultControllerName: cfg.AgwControllerName,
 		classInfo:             classInfo,
 		gwClassClient:         kclient.NewFilteredDelayed[*gwv1.GatewayClass](cfg.Client, gvr.GatewayClass, filter),
+		agwParamClient:        kclient.NewFilteredDelayed[*agentgateway.AgentgatewayParameters](cfg.Client, wellknown.AgentgatewayParametersGVR, filter),
 		client:                cfg.Client,
 	}
 	r.queue = controllers.NewQueue("GatewayClassController", controllers.WithReconciler(r.reconcile), controllers.WithMaxAttempts(math.MaxInt), controllers.WithRateLimiter(rateLimiter))
@@ -77,6 +81,19 @@ func newGatewayClassReconciler(
 			}
 		}))
 
+	// Re-validate GatewayClasses whose parametersRef points at an AgentgatewayParameters
+	// that was created or deleted, so the Accepted condition follows the reference.
+	r.agwParamClient.AddEventHandler(controllers.ObjectHandler(func(o controllers.Object) {
+		for _, gwc := range r.gwClassClient.List(metav1.NamespaceAll, labels.Everything()) {
+			if !isOurGatewayClass(gwc, ourControllers) || !gatewayClassReferencesParameters(gwc, o) {
+				continue
+			}
+			logger.Debug("reconciling GatewayClass due to AgentgatewayParameters change",
+				"ref", kubeutils.NamespacedNameFrom(gwc), "agwparam", kubeutils.NamespacedNameFrom(o))
+			r.queue.AddObject(gwc)
+		}
+	}))
+
 	return r
 }
 
@@ -91,11 +108,11 @@ func (r *gatewayClassReconciler) Start(ctx context.Context) error {
 	r.queue.Add(emptyGatewayClass)
 
 	// Wait for all caches to sync
-	kube.WaitForCacheSync("GatewayClassController", ctx.Done(), r.gwClassClient.HasSynced)
+	kube.WaitForCacheSync("GatewayClassController", ctx.Done(), r.gwClassClient.HasSynced, r.agwParamClient.HasSynced)
 	r.queue.Run(ctx.Done())
 
 	// Shutdown all the clients
-	controllers.ShutdownAll(r.gwClassClient)
+	controllers.ShutdownAll(r.gwClassClient, r.agwParamClient)
 	return nil
 }
 
@@ -124,13 +141,19 @@ func (r *gatewayClassReconciler) reconcile(req types.NamespacedName) (rErr error
 
 	// Update status
 	status := gwClass.Status
-	meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+	accepted := metav1.Condition{
 		Type:               string(gwv1.GatewayClassConditionStatusAccepted),
 		Status:             metav1.ConditionTrue,
 		Reason:             string(gwv1.GatewayClassReasonAccepted),
 		ObservedGeneration: gwClass.Generation,
 		Message:            reports.GatewayClassAcceptedMessage,
-	})
+	}
+	if err := r.validateParametersRef(gwClass); err != nil {
+		accepted.Status = metav1.ConditionFalse
+		accepted.Reason = string(gwv1.GatewayClassReasonInvalidParameters)
+		accepted.Message = err.Error()
+	}
+	meta.SetStatusCondition(&status.Conditions, accepted)
 	if i, ok := r.classInfo[gwClass.Name]; ok {
 		status.SupportedFeatures = i.SupportedFeatures
 	}
@@ -217,6 +240,35 @@ func (r *gatewayClassReconciler) applyGatewayClass(gwc *gwv1.GatewayClass, contr
 	return err
 }
 
+// validateParametersRef checks that the GatewayClass parametersRef, if any, points at an
+// existing AgentgatewayParameters. Gateways of the class already fail to deploy in that
+// case; this surfaces the reason on the GatewayClass itself.
+func (r *gatewayClassReconciler) validateParametersRef(gwc *gwv1.GatewayClass) error {
+	ref := gwc.Spec.ParametersRef
+	if ref == nil {
+		return nil
+	}
+	if ref.Group != agentgateway.GroupName || string(ref.Kind) != wellknown.AgentgatewayParametersGVK.Kind {
+		return fmt.Errorf("parametersRef references unsupported type: group=%s kind=%s; use AgentgatewayParameters instead", ref.Group, ref.Kind)
+	}
+	if ref.Namespace == nil {
+		return fmt.Errorf("parametersRef to AgentgatewayParameters %s must set a namespace", ref.Name)
+	}
+	if r.agwParamClient.Get(ref.Name, string(*ref.Namespace)) == nil {
+		return fmt.Errorf("AgentgatewayParameters %s/%s not found", *ref.Namespace, ref.Name)
+	}
+	return nil
+}
+
+func gatewayClassReferencesParameters(gwc *gwv1.GatewayClass, params controllers.Object) bool {
+	ref := gwc.Spec.ParametersRef
+	return ref != nil &&
+		ref.Group == agentgateway.GroupName &&
+		string(ref.Kind) == wellknown.AgentgatewayParametersGVK.Kind &&
+		ref.Name == params.GetName() &&
+		ref.Namespace != nil && string(*ref.Namespace) == params.GetNamespace()
+}
+
 func isOurGatewayClass(gwc *gwv1.GatewayClass, ourControllers sets.Set[string]) bool {
 	return ourControllers.Has(string(gwc.Spec.ControllerName))
 }
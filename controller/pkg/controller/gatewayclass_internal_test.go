@@ -0,0 +1,101 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"istio.io/istio/pkg/config/schema/gvr"
+	"istio.io/istio/pkg/kube"
+	"istio.io/istio/pkg/kube/kclient"
+	"istio.io/istio/pkg/test"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/agentgateway/agentgateway/controller/api/v1alpha1/agentgateway"
+	"github.com/agentgateway/agentgateway/controller/pkg/apiclient/fake"
+	"github.com/agentgateway/agentgateway/controller/pkg/deployer"
+	"github.com/agentgateway/agentgateway/controller/pkg/wellknown"
+)
+
+func TestGatewayClassReconciler_ParametersRefSetsAcceptedCondition(t *testing.T) {
+	const namespace = "default"
+	paramsNamespace := gwv1.Namespace(namespace)
+	gwc := &gwv1.GatewayClass{
+		ObjectMeta: metav1.ObjectMeta{Name: wellknown.DefaultAgwClassName, Generation: 3},
+		Spec: gwv1.GatewayClassSpec{
+			ControllerName: gwv1.GatewayController(wellknown.DefaultAgwControllerName),
+			ParametersRef: &gwv1.ParametersReference{
+				Group:     agentgateway.GroupName,
+				Kind:      gwv1.Kind(wellknown.AgentgatewayParametersGVK.Kind),
+				Name:      "class-params",
+				Namespace: &paramsNamespace,
+			},
+		},
+	}
+	fakeClient := fake.NewClient(t, gwc)
+	filter := kclient.Filter{ObjectFilter: fakeClient.ObjectFilter()}
+	reconciler := &gatewayClassReconciler{
+		defaultControllerName: wellknown.DefaultAgwControllerName,
+		classInfo:             map[string]*deployer.GatewayClassInfo{},
+		gwClassClient:         kclient.NewFilteredDelayed[*gwv1.GatewayClass](fakeClient, gvr.GatewayClass, filter),
+		agwParamClient:        kclient.NewFilteredDelayed[*agentgateway.AgentgatewayParameters](fakeClient, wellknown.AgentgatewayParametersGVR, filter),
+		client:                fakeClient,
+	}
+	stop := test.NewStop(t)
+	fakeClient.RunAndWait(stop)
+	kube.WaitForCacheSync("test-gatewayclass-reconciler", stop, reconciler.gwClassClient.HasSynced, reconciler.agwParamClient.HasSynced)
+
+	accepted := func(t *testing.T, status metav1.ConditionStatus) *metav1.Condition {
+		t.Helper()
+		var cond *metav1.Condition
+		assert.EventuallyWithT(t, func(c *assert.CollectT) {
+			updated := reconciler.gwClassClient.Get(gwc.Name, "")
+			require.NotNil(c, updated)
+			cond = meta.FindStatusCondition(updated.Status.Conditions, string(gwv1.GatewayClassConditionStatusAccepted))
+			require.NotNil(c, cond)
+			assert.Equal(c, status, cond.Status)
+		}, time.Second, 10*time.Millisecond)
+		require.NotNil(t, cond)
+		return cond
+	}
+
+	// A dangling reference marks the class as not accepted.
+	require.NoError(t, reconciler.reconcile(types.NamespacedName{Name: gwc.Name}))
+	cond := accepted(t, metav1.ConditionFalse)
+	assert.Equal(t, string(gwv1.GatewayClassReasonInvalidParameters), cond.Reason)
+	assert.Equal(t, gwc.Generation, cond.ObservedGeneration)
+	assert.Contains(t, cond.Message, "AgentgatewayParameters default/class-params not found")
+
+	// Once the parameters exist, the condition clears.
+	_, err := kclient.New[*agentgateway.AgentgatewayParameters](fakeClient).Create(&agentgateway.AgentgatewayParameters{
+		ObjectMeta: metav1.ObjectMeta{Name: "class-params", Namespace: namespace},
+	})
+	require.NoError(t, err)
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		assert.NotNil(c, reconciler.agwParamClient.Get("class-params", namespace))
+	}, time.Second, 10*time.Millisecond)
+	require.NoError(t, reconciler.reconcile(types.NamespacedName{Name: gwc.Name}))
+	cond = accepted(t, metav1.ConditionTrue)
+	assert.Equal(t, string(gwv1.GatewayClassReasonAccepted), cond.Reason)
+}
+
+func TestGatewayClassReconciler_ValidateParametersRefWrongKind(t *testing.T) {
+	paramsNamespace := gwv1.Namespace("default")
+	r := &gatewayClassReconciler{}
+	err := r.validateParametersRef(&gwv1.GatewayClass{
+		Spec: gwv1.GatewayClassSpec{
+			ParametersRef: &gwv1.ParametersReference{
+				Group:     "",
+				Kind:      "ConfigMap",
+				Name:      "params",
+				Namespace: &paramsNamespace,
+			},
+		},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported type")
+}
@@ -1,21 +1,32 @@
 package deployer
 
 import (
+	"bytes"
 	"context"
 	"crypto/rand"
 	"crypto/sha256"
 	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"maps"
+	"net/url"
+	"slices"
+	"strconv"
 	"strings"
 
+	"github.com/distribution/reference"
 	"istio.io/istio/pkg/kube/kclient"
+	"istio.io/istio/pkg/kube/krt"
 	"istio.io/istio/pkg/util/smallset"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
@@ -27,13 +38,38 @@ import (
 	"github.com/agentgateway/agentgateway/controller/pkg/wellknown"
 )
 
-const sessionKeyEnvVar = "SESSION_KEY"
+const (
+	sessionKeyEnvVar = "SESSION_KEY"
+	// otlpHeadersEnvVar carries the tracing secret headers to the proxy.
+	otlpHeadersEnvVar = "OTLP_HEADERS"
+	// proxyContainerName is the name of the proxy container in the generated pod.
+	proxyContainerName = "agentgateway"
+)
 
 var defaultResourceRequests = corev1.ResourceList{
 	corev1.ResourceCPU:    resource.MustParse("100m"),
 	corev1.ResourceMemory: resource.MustParse("128Mi"),
 }
 
+// defaultPodSecurityContext lets the non-root proxy bind privileged ports.
+func defaultPodSecurityContext() *corev1.PodSecurityContext {
+	return &corev1.PodSecurityContext{
+		Sysctls: []corev1.Sysctl{{Name: "net.ipv4.ip_unprivileged_port_start", Value: "0"}},
+	}
+}
+
+func defaultSecurityContext() *corev1.SecurityContext {
+	return &corev1.SecurityContext{
+		AllowPrivilegeEscalation: ptr.To(false),
+		Capabilities: &corev1.Capabilities{
+			Drop: []corev1.Capability{"ALL"},
+		},
+		ReadOnlyRootFilesystem: ptr.To(true),
+		RunAsNonRoot:           ptr.To(true),
+		RunAsUser:              ptr.To[int64](10101),
+	}
+}
+
 // AgentgatewayParametersApplier applies AgentgatewayParameters configurations and overlays.
 type AgentgatewayParametersApplier struct {
 	params *agentgateway.AgentgatewayParameters
@@ -100,6 +136,11 @@ func ResolveIstioIntegration(gtw *AgentgatewayHelmGateway, cols *agwplugins.AgwC
 // ApplyToHelmValues applies the AgentgatewayParameters configs to the helm
 // values.  This is called before rendering the helm chart. (We render a helm
 // chart, but we do not use helm beyond that point.)
+//
+// It is called once per layer (GatewayClass, then Gateway), and merges field by
+// field: a field set on a later layer overrides the earlier value, while fields
+// the later layer leaves unset keep the earlier value. Maps (resources, env by
+// name, rawConfig objects) are merged key by key; lists are replaced.
 func (a *AgentgatewayParametersApplier) ApplyToHelmValues(vals *HelmConfig) {
 	if a.params == nil || vals == nil || vals.Agentgateway == nil {
 		return
@@ -132,7 +173,36 @@ func (a *AgentgatewayParametersApplier) ApplyToHelmValues(vals *HelmConfig) {
 	// Merge resources field-by-field to preserve values from GatewayClass AGWP
 	// when Gateway AGWP only sets some fields (e.g., GWC sets limits, GW sets requests).
 	res.Resources = DeepMergeResourceRequirements(res.Resources, configs.Resources)
+	res.PodSecurityContext = mergePodSecurityContext(res.PodSecurityContext, configs.PodSecurityContext)
+	res.SecurityContext = mergeSecurityContext(res.SecurityContext, configs.SecurityContext)
 	setIfNonNil(&res.Shutdown, configs.Shutdown)
+	setIfNonNil(&res.Replicas, configs.Replicas)
+	setIfNonNil(&res.Strategy, configs.Strategy)
+	res.NodeSelector = DeepMergeMaps(res.NodeSelector, configs.NodeSelector)
+	if len(configs.Tolerations) > 0 {
+		res.Tolerations = configs.Tolerations
+	}
+	if len(configs.InitContainers) > 0 {
+		res.InitContainers = configs.InitContainers
+	}
+	if len(configs.Sidecars) > 0 {
+		res.Sidecars = configs.Sidecars
+	}
+	setIfNonNil(&res.PriorityClassName, configs.PriorityClassName)
+	setIfNonNil(&res.ServiceAccountName, configs.ServiceAccountName)
+	if len(configs.ImagePullSecrets) > 0 {
+		res.ImagePullSecrets = configs.ImagePullSecrets
+	}
+	// minAvailable and maxUnavailable are mutually exclusive, so replace rather than merge.
+	setIfNonNil(&res.PodDisruptionBudgetConfig, configs.PodDisruptionBudgetConfig)
+	if configs.ServiceConfig != nil {
+		if res.ServiceConfig == nil {
+			res.ServiceConfig = &agentgateway.AgentgatewayParametersServiceConfig{}
+		}
+		setIfNonNil(&res.ServiceConfig.Type, configs.ServiceConfig.Type)
+		setIfNonNil(&res.ServiceConfig.LoadBalancerClass, configs.ServiceConfig.LoadBalancerClass)
+		res.ServiceConfig.Annotations = DeepMergeMaps(res.ServiceConfig.Annotations, configs.ServiceConfig.Annotations)
+	}
 	// Merge Istio field-by-field to preserve values from GatewayClass AGWP
 	// when Gateway AGWP only sets some fields (e.g., GWC sets caAddress, GW sets trustDomain).
 	if configs.Istio != nil {
@@ -148,7 +218,7 @@ func (a *AgentgatewayParametersApplier) ApplyToHelmValues(vals *HelmConfig) {
 			res.Istio.AdditionalTrustDomains = configs.Istio.AdditionalTrustDomains
 		}
 	}
-	setIfNonNil(&res.RawConfig, configs.RawConfig)
+	res.RawConfig = mergeRawConfig(res.RawConfig, configs.RawConfig)
 
 	// Apply logging.level as RUST_LOG first, then merge explicit env vars on top.
 	// This ensures explicit env vars override logging.level if both specify RUST_LOG.
@@ -160,12 +230,273 @@ func (a *AgentgatewayParametersApplier) ApplyToHelmValues(vals *HelmConfig) {
 		setIfNonZero(&res.Logging.Format, configs.Logging.Format)
 	}
 
+	if configs.Admin != nil {
+		if res.Admin == nil {
+			res.Admin = &agentgateway.AgentgatewayParametersAdmin{}
+		}
+		setIfNonNil(&res.Admin.Port, configs.Admin.Port)
+	}
+
+	if configs.Tracing != nil {
+		if res.Tracing == nil {
+			res.Tracing = &agentgateway.AgentgatewayParametersTracing{}
+		}
+		if len(configs.Tracing.SecretHeaders) > 0 {
+			res.Tracing.SecretHeaders = configs.Tracing.SecretHeaders
+		}
+	}
+
 	// Apply explicit environment variables last so they can override logging.level.
 	res.Env = mergeEnvVars(res.Env, configs.Env)
 
+	res.Labels = DeepMergeMaps(res.Labels, configs.Labels)
+	res.Annotations = DeepMergeMaps(res.Annotations, configs.Annotations)
+
 	vals.Agentgateway.AgentgatewayParametersConfigs = res
 }
 
+// managedMetadataKeys are labels the chart sets on generated resources. They are
+// dropped from AgentgatewayParameters labels so users cannot clobber them.
+var managedMetadataKeys = sets.New(
+	"app.kubernetes.io/name",
+	"app.kubernetes.io/instance",
+	"app.kubernetes.io/managed-by",
+	"app.kubernetes.io/version",
+	"istio.io/dataplane-mode",
+	"sidecar.istio.io/inject",
+)
+
+func isManagedMetadataKey(key string) bool {
+	return managedMetadataKeys.Has(key) ||
+		strings.HasPrefix(key, "gateway.networking.k8s.io/") ||
+		strings.HasPrefix(key, "checksum/")
+}
+
+// applyParametersMetadata folds the AgentgatewayParameters labels and annotations into
+// the gateway metadata the chart renders on every resource. Managed keys are dropped,
+// and values from the Gateway's spec.infrastructure take precedence.
+func applyParametersMetadata(gtw *AgentgatewayHelmGateway) {
+	if gtw == nil {
+		return
+	}
+	gtw.GatewayLabels = mergeParametersMetadata(gtw.Labels, gtw.GatewayLabels)
+	gtw.GatewayAnnotations = mergeParametersMetadata(gtw.Annotations, gtw.GatewayAnnotations)
+	gtw.Labels = nil
+	gtw.Annotations = nil
+}
+
+func mergeParametersMetadata(params, infra map[string]string) map[string]string {
+	if len(params) == 0 {
+		return infra
+	}
+	out := make(map[string]string, len(params)+len(infra))
+	for k, v := range params {
+		if isManagedMetadataKey(k) {
+			continue
+		}
+		out[k] = v
+	}
+	maps.Copy(out, infra)
+	return out
+}
+
+// applyServiceConfig moves the merged serviceConfig onto the rendered Service values.
+// Layers are merged field by field, so the combination is validated here as well.
+func applyServiceConfig(gtw *AgentgatewayHelmGateway) error {
+	cfg := gtw.ServiceConfig
+	gtw.ServiceConfig = nil
+	if cfg == nil {
+		return nil
+	}
+	if gtw.Service == nil {
+		gtw.Service = &AgentgatewayHelmService{}
+	}
+	if cfg.Type != nil {
+		gtw.Service.Type = new(string(*cfg.Type))
+	}
+	if cfg.LoadBalancerClass != nil {
+		if cfg.Type != nil && *cfg.Type != corev1.ServiceTypeLoadBalancer {
+			return fmt.Errorf("serviceConfig.loadBalancerClass may only be set when type is LoadBalancer, got %s", *cfg.Type)
+		}
+		gtw.Service.LoadBalancerClass = cfg.LoadBalancerClass
+	}
+	gtw.Service.Annotations = cfg.Annotations
+	return nil
+}
+
+// validateImage checks that the merged proxy image, assembled the same way as the
+// chart does, is a valid image reference. Each field is validated on its own by the
+// CRD, but the combination can only be checked once the layers are merged.
+func validateImage(img *agentgateway.Image) error {
+	if img == nil || ptr.Deref(img.Repository, "") == "" {
+		return errors.New("an image repository must be set")
+	}
+	ref := *img.Repository
+	if r := ptr.Deref(img.Registry, ""); r != "" {
+		ref = r + "/" + ref
+	}
+	if t := ptr.Deref(img.Tag, ""); t != "" {
+		ref += ":" + t
+	}
+	if d := ptr.Deref(img.Digest, ""); d != "" {
+		ref += "@" + d
+	}
+	if _, err := reference.ParseNormalizedNamed(ref); err != nil {
+		return fmt.Errorf("invalid image %q: %w", ref, err)
+	}
+	return nil
+}
+
+// validateAdmin checks that the merged admin port does not collide with a Gateway
+// listener, which the CRD cannot see.
+func validateAdmin(gtw *AgentgatewayHelmGateway) error {
+	if gtw.Admin == nil {
+		return nil
+	}
+	port := ptr.Deref(gtw.Admin.Port, wellknown.ProxyAdminPort)
+	for _, p := range gtw.Ports {
+		if p.Port != nil && *p.Port == port {
+			return fmt.Errorf("admin port %d is already used by a Gateway listener", port)
+		}
+	}
+	return nil
+}
+
+// validateTracingEndpoint checks the merged rawConfig's config.tracing.otlpEndpoint.
+// The proxy only parses it at startup, so a typo would otherwise leave tracing
+// silently broken on a running pod.
+func validateTracingEndpoint(raw *apiextensionsv1.JSON) error {
+	if raw == nil {
+		return nil
+	}
+	obj, err := decodeRawConfigObject(raw)
+	if err != nil {
+		return fmt.Errorf("invalid rawConfig: must be a JSON object: %w", err)
+	}
+	config, _ := obj["config"].(map[string]any)
+	tracing, _ := config["tracing"].(map[string]any)
+	v, ok := tracing["otlpEndpoint"]
+	if !ok || v == nil {
+		return nil
+	}
+	endpoint, ok := v.(string)
+	if !ok {
+		return errors.New("invalid rawConfig config.tracing.otlpEndpoint: must be a string")
+	}
+	if endpoint == "" {
+		return nil
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return fmt.Errorf("invalid rawConfig config.tracing.otlpEndpoint %q: %w", endpoint, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("invalid rawConfig config.tracing.otlpEndpoint %q: scheme must be http or https", endpoint)
+	}
+	if u.Hostname() == "" {
+		return fmt.Errorf("invalid rawConfig config.tracing.otlpEndpoint %q: a host must be set", endpoint)
+	}
+	if p := u.Port(); p != "" {
+		if n, err := strconv.Atoi(p); err != nil || n < 1 || n > 65535 {
+			return fmt.Errorf("invalid rawConfig config.tracing.otlpEndpoint %q: port must be between 1 and 65535", endpoint)
+		}
+	}
+	return nil
+}
+
+// validateTracingSecretHeaders checks that every tracing secret header resolves to an
+// existing Secret key. The values reach the proxy through OTLP_HEADERS, so they must
+// not contain the comma separator, and OTLP_HEADERS must not also be set through env.
+func (g *agentgatewayParametersHelmValuesGenerator) validateTracingSecretHeaders(namespace string, gtw *AgentgatewayHelmGateway) error {
+	if gtw.Tracing == nil || len(gtw.Tracing.SecretHeaders) == 0 {
+		return nil
+	}
+	if slices.ContainsFunc(gtw.Env, func(e corev1.EnvVar) bool { return e.Name == otlpHeadersEnvVar }) {
+		return fmt.Errorf("env %s cannot be set together with tracing.secretHeaders", otlpHeadersEnvVar)
+	}
+	for _, h := range gtw.Tracing.SecretHeaders {
+		ref := h.SecretKeyRef
+		secret := g.secretClient.Get(ref.Name, namespace)
+		if secret == nil {
+			return fmt.Errorf("tracing secret header %q references Secret %s/%s, which does not exist", h.Name, namespace, ref.Name)
+		}
+		value, found := secret.Data[ref.Key]
+		if !found {
+			return fmt.Errorf("tracing secret header %q references key %q, which is missing from Secret %s/%s", h.Name, ref.Key, namespace, ref.Name)
+		}
+		if bytes.ContainsRune(value, ',') {
+			return fmt.Errorf("tracing secret header %q value in Secret %s/%s must not contain commas", h.Name, namespace, ref.Name)
+		}
+	}
+	return nil
+}
+
+// validateExtraContainers checks that merged initContainers and sidecars neither
+// replace the proxy container nor collide with each other.
+func validateExtraContainers(gtw *AgentgatewayHelmGateway) error {
+	names := sets.New(proxyContainerName)
+	for _, c := range slices.Concat(gtw.InitContainers, gtw.Sidecars) {
+		if names.Has(c.Name) {
+			return fmt.Errorf("container name %q is already in use by the data plane pod", c.Name)
+		}
+		names.Insert(c.Name)
+	}
+	return nil
+}
+
+// mergeRawConfig deep merges two rawConfig objects. Keys in override take precedence;
+// nested objects are merged recursively, and any other value (including lists) is
+// replaced. If either side is not a JSON object, that side is returned unmerged so
+// validateTracingEndpoint rejects it.
+func mergeRawConfig(base, override *apiextensionsv1.JSON) *apiextensionsv1.JSON {
+	if override == nil {
+		return base
+	}
+	if base == nil {
+		return override
+	}
+	b, err := decodeRawConfigObject(base)
+	if err != nil {
+		return base
+	}
+	o, err := decodeRawConfigObject(override)
+	if err != nil {
+		return override
+	}
+	merged, err := json.Marshal(deepMergeJSONObjects(b, o))
+	if err != nil {
+		return override
+	}
+	return &apiextensionsv1.JSON{Raw: merged}
+}
+
+// decodeRawConfigObject decodes numbers as json.Number so they round-trip unchanged.
+func decodeRawConfigObject(raw *apiextensionsv1.JSON) (map[string]any, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw.Raw))
+	dec.UseNumber()
+	var out map[string]any
+	if err := dec.Decode(&out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func deepMergeJSONObjects(base, override map[string]any) map[string]any {
+	if base == nil {
+		return override
+	}
+	for k, ov := range override {
+		bm, bok := base[k].(map[string]any)
+		om, ook := ov.(map[string]any)
+		if bok && ook {
+			base[k] = deepMergeJSONObjects(bm, om)
+			continue
+		}
+		base[k] = ov
+	}
+	return base
+}
+
 // mergeEnvVars merges two slices of environment variables.
 // Variables in 'override' take precedence over variables in 'base' with the same name.
 // The order is preserved: base vars first (minus overridden ones), then override vars.
@@ -280,6 +611,31 @@ func (g *agentgatewayParametersHelmValuesGenerator) GetValues(ctx context.Contex
 		vals.Agentgateway.ModelCatalog = resolved.gatewayAGWP.Spec.ModelCatalog.DeepCopy()
 	}
 
+	applyParametersMetadata(vals.Agentgateway)
+	if err := applyServiceConfig(vals.Agentgateway); err != nil {
+		return nil, err
+	}
+	if err := validateExtraContainers(vals.Agentgateway); err != nil {
+		return nil, err
+	}
+	if err := validateImage(vals.Agentgateway.Image); err != nil {
+		return nil, err
+	}
+	if err := validateAdmin(vals.Agentgateway); err != nil {
+		return nil, err
+	}
+	if err := validateTracingEndpoint(vals.Agentgateway.RawConfig); err != nil {
+		return nil, err
+	}
+	if err := g.validateTracingSecretHeaders(gw.Namespace, vals.Agentgateway); err != nil {
+		return nil, err
+	}
+	// An autoscaler owns the replica count; setting it on the Deployment as well
+	// would make every reconcile fight the autoscaler.
+	if resolved.hasHorizontalPodAutoscaler() {
+		vals.Agentgateway.Replicas = nil
+	}
+
 	// Resolve Istio enablement and defaults after gw params so spec.istio takes precedence.
 	ResolveIstioIntegration(vals.Agentgateway, g.inputs.AgwCollections)
 
@@ -334,6 +690,15 @@ func (r *resolvedParameters) layers() []parametersLayer {
 	}
 }
 
+func (r *resolvedParameters) hasHorizontalPodAutoscaler() bool {
+	for _, layer := range r.layers() {
+		if layer.params != nil && layer.params.Spec.HorizontalPodAutoscaler != nil {
+			return true
+		}
+	}
+	return false
+}
+
 func (r *resolvedParameters) resolveWorkloadKind() (agentgateway.AgentgatewayParametersWorkloadKind, error) {
 	resolved := agentgateway.AgentgatewayParametersWorkloadDeployment
 
@@ -422,8 +787,8 @@ func (g *agentgatewayParametersHelmValuesGenerator) resolveParameters(gw *gwv1.G
 			}
 			result.gatewayClassAGWP = agwp
 		} else {
-			return nil, fmt.Errorf("the GatewayClass %s references parameters of a type other than AgentgatewayParameters: %s",
-				gwc.GetName(), ref.Name)
+			return nil, fmt.Errorf("the GatewayClass %s references parameters of a type other than AgentgatewayParameters: group=%s kind=%s",
+				gwc.GetName(), ref.Group, ref.Kind)
 		}
 	}
 
@@ -490,6 +855,42 @@ func (g *agentgatewayParametersHelmValuesGenerator) GetCacheSyncHandlers() []cac
 func (g *agentgatewayParametersHelmValuesGenerator) GetResolvedParametersForGateway(gw *gwv1.Gateway) (*resolvedParameters, error) {
 	return g.resolveParameters(gw)
 }
+
+// serviceAccountName returns the name of the ServiceAccount the data plane pods of the named Gateway
+// run as: the serviceAccountName of the resolved parameters, or the managed ServiceAccount named after
+// the Gateway.
+func (r *resolvedParameters) serviceAccountName(gatewayName string) string {
+	name := gatewayName
+	for _, layer := range r.layers() {
+		if layer.params != nil && layer.params.Spec.ServiceAccountName != nil {
+			name = *layer.params.Spec.ServiceAccountName
+		}
+	}
+	return name
+}
+
+// NewGatewayServiceAccountLookup returns a function resolving the ServiceAccount the data plane of a
+// Gateway runs as, from the Gateway's effective AgentgatewayParameters. It reports false if the Gateway
+// does not exist or its parameters cannot be resolved. The clients it creates must be started with cli.
+func NewGatewayServiceAccountLookup(cli apiclient.Client, gateways krt.Collection[*gwv1.Gateway]) func(types.NamespacedName) (types.NamespacedName, bool) {
+	filter := kclient.Filter{ObjectFilter: cli.ObjectFilter()}
+	g := &agentgatewayParametersHelmValuesGenerator{
+		agwParamClient: kclient.NewFilteredDelayed[*agentgateway.AgentgatewayParameters](cli, wellknown.AgentgatewayParametersGVR, filter),
+		gwClassClient:  kclient.NewFilteredDelayed[*gwv1.GatewayClass](cli, wellknown.GatewayClassGVR, filter),
+	}
+	return func(gateway types.NamespacedName) (types.NamespacedName, bool) {
+		gw := gateways.GetKey(gateway.String())
+		if gw == nil {
+			return types.NamespacedName{}, false
+		}
+		resolved, err := g.resolveParameters(*gw)
+		if err != nil {
+			return types.NamespacedName{}, false
+		}
+		return types.NamespacedName{Namespace: gateway.Namespace, Name: resolved.serviceAccountName(gateway.Name)}, true
+	}
+}
+
 func DefaultGatewayIRGetter(gw *gwv1.Gateway, agwCollections *agwplugins.AgwCollections) *collections.GatewayForDeployer {
 	gwKey := collections.ObjectSource{
 		Group:     wellknown.GatewayGVK.GroupKind().Group,
@@ -533,6 +934,8 @@ func (g *agentgatewayParametersHelmValuesGenerator) getDefaultAgentgatewayHelmVa
 			Resources: &corev1.ResourceRequirements{
 				Requests: defaultResourceRequests.DeepCopy(),
 			},
+			PodSecurityContext: defaultPodSecurityContext(),
+			SecurityContext:    defaultSecurityContext(),
 		},
 	}
 
@@ -685,6 +1088,80 @@ func DeepMergeResourceRequirements(dst, src *corev1.ResourceRequirements) *corev
 	return dst
 }
 
+// mergePodSecurityContext merges src over dst field by field. Sysctls are merged by
+// name; other lists are replaced when set.
+func mergePodSecurityContext(dst, src *corev1.PodSecurityContext) *corev1.PodSecurityContext {
+	if src == nil {
+		return dst
+	}
+	if dst == nil {
+		return src
+	}
+	setIfNonNil(&dst.SELinuxOptions, src.SELinuxOptions)
+	setIfNonNil(&dst.WindowsOptions, src.WindowsOptions)
+	setIfNonNil(&dst.RunAsUser, src.RunAsUser)
+	setIfNonNil(&dst.RunAsGroup, src.RunAsGroup)
+	setIfNonNil(&dst.RunAsNonRoot, src.RunAsNonRoot)
+	if src.SupplementalGroups != nil {
+		dst.SupplementalGroups = src.SupplementalGroups
+	}
+	setIfNonNil(&dst.SupplementalGroupsPolicy, src.SupplementalGroupsPolicy)
+	setIfNonNil(&dst.FSGroup, src.FSGroup)
+	dst.Sysctls = mergeSysctls(dst.Sysctls, src.Sysctls)
+	setIfNonNil(&dst.FSGroupChangePolicy, src.FSGroupChangePolicy)
+	setIfNonNil(&dst.SeccompProfile, src.SeccompProfile)
+	setIfNonNil(&dst.AppArmorProfile, src.AppArmorProfile)
+	setIfNonNil(&dst.SELinuxChangePolicy, src.SELinuxChangePolicy)
+	return dst
+}
+
+// mergeSecurityContext merges src over dst field by field. The added and dropped
+// capabilities are replaced independently, so adding a capability keeps the
+// default drop list.
+func mergeSecurityContext(dst, src *corev1.SecurityContext) *corev1.SecurityContext {
+	if src == nil {
+		return dst
+	}
+	if dst == nil {
+		return src
+	}
+	if src.Capabilities != nil {
+		if dst.Capabilities == nil {
+			dst.Capabilities = &corev1.Capabilities{}
+		}
+		if src.Capabilities.Add != nil {
+			dst.Capabilities.Add = src.Capabilities.Add
+		}
+		if src.Capabilities.Drop != nil {
+			dst.Capabilities.Drop = src.Capabilities.Drop
+		}
+	}
+	setIfNonNil(&dst.Privileged, src.Privileged)
+	setIfNonNil(&dst.SELinuxOptions, src.SELinuxOptions)
+	setIfNonNil(&dst.WindowsOptions, src.WindowsOptions)
+	setIfNonNil(&dst.RunAsUser, src.RunAsUser)
+	setIfNonNil(&dst.RunAsGroup, src.RunAsGroup)
+	setIfNonNil(&dst.RunAsNonRoot, src.RunAsNonRoot)
+	setIfNonNil(&dst.ReadOnlyRootFilesystem, src.ReadOnlyRootFilesystem)
+	setIfNonNil(&dst.AllowPrivilegeEscalation, src.AllowPrivilegeEscalation)
+	setIfNonNil(&dst.ProcMount, src.ProcMount)
+	setIfNonNil(&dst.SeccompProfile, src.SeccompProfile)
+	setIfNonNil(&dst.AppArmorProfile, src.AppArmorProfile)
+	return dst
+}
+
+// mergeSysctls merges two lists of sysctls. Entries in override replace entries in
+// base with the same name.
+func mergeSysctls(base, override []corev1.Sysctl) []corev1.Sysctl {
+	if len(override) == 0 {
+		return base
+	}
+	result := slices.DeleteFunc(slices.Clone(base), func(b corev1.Sysctl) bool {
+		return slices.ContainsFunc(override, func(o corev1.Sysctl) bool { return o.Name == b.Name })
+	})
+	return append(result, override...)
+}
+
 // DeepMergeMaps will use dst if src is nil, src if dest is nil, or add all entries from src into dst
 // if neither are nil
 func DeepMergeMaps[keyT comparable, valT any](dst, src map[keyT]valT) map[keyT]valT {
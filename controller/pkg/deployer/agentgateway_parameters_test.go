@@ -2,7 +2,10 @@ package deployer
 
 import (
 	"context"
+	"maps"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -17,6 +20,7 @@ import (
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -27,6 +31,7 @@ import (
 	"github.com/agentgateway/agentgateway/controller/pkg/apiclient/fake"
 	"github.com/agentgateway/agentgateway/controller/pkg/pluginsdk/collections"
 	"github.com/agentgateway/agentgateway/controller/pkg/schemes"
+	"github.com/agentgateway/agentgateway/controller/pkg/utils/kubeutils"
 	"github.com/agentgateway/agentgateway/controller/pkg/wellknown"
 )
 
@@ -702,6 +707,812 @@ func TestGetObjsToDeploy_MergesSupportResourceOverlays(t *testing.T) {
 	assert.Equal(t, "Deployment", hpa.Spec.ScaleTargetRef.Kind)
 }
 
+func TestGetObjsToDeploy_GatewayParametersOverrideClassDefaults(t *testing.T) {
+	const (
+		namespace       = "default"
+		classParamsName = "class-params"
+		gwParamsName    = "gateway-params"
+	)
+	paramsNamespace := gwv1.Namespace(namespace)
+
+	newGateway := func(name string, infra *gwv1.GatewayInfrastructure) *gwv1.Gateway {
+		gw := &gwv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: gwv1.GatewaySpec{
+				GatewayClassName: gwv1.ObjectName(wellknown.DefaultAgwClassName),
+				Infrastructure:   infra,
+				Listeners: []gwv1.Listener{{
+					Name:     "http",
+					Protocol: gwv1.HTTPProtocolType,
+					Port:     8080,
+				}},
+			},
+		}
+		gw.SetGroupVersionKind(wellknown.GatewayGVK)
+		return gw
+	}
+	overridden := newGateway("overridden", &gwv1.GatewayInfrastructure{
+		ParametersRef: &gwv1.LocalParametersReference{
+			Group: agentgateway.GroupName,
+			Kind:  gwv1.Kind(wellknown.AgentgatewayParametersGVK.Kind),
+			Name:  gwParamsName,
+		},
+	})
+	defaulted := newGateway("defaulted", nil)
+	unsupported := newGateway("unsupported", &gwv1.GatewayInfrastructure{
+		ParametersRef: &gwv1.LocalParametersReference{
+			Group: "example.com",
+			Kind:  gwv1.Kind(wellknown.AgentgatewayParametersGVK.Kind),
+			Name:  gwParamsName,
+		},
+	})
+	gwc := &gwv1.GatewayClass{
+		ObjectMeta: metav1.ObjectMeta{Name: wellknown.DefaultAgwClassName},
+		Spec: gwv1.GatewayClassSpec{
+			ControllerName: gwv1.GatewayController(wellknown.DefaultAgwControllerName),
+			ParametersRef: &gwv1.ParametersReference{
+				Group:     agentgateway.GroupName,
+				Kind:      gwv1.Kind(wellknown.AgentgatewayParametersGVK.Kind),
+				Name:      classParamsName,
+				Namespace: &paramsNamespace,
+			},
+		},
+	}
+	classParams := &agentgateway.AgentgatewayParameters{
+		ObjectMeta: metav1.ObjectMeta{Name: classParamsName, Namespace: namespace},
+		Spec: agentgateway.AgentgatewayParametersSpec{
+			AgentgatewayParametersConfigs: agentgateway.AgentgatewayParametersConfigs{
+				Env: []corev1.EnvVar{
+					{Name: "LOG_LEVEL", Value: "info"},
+					{Name: "REGION", Value: "class"},
+				},
+			},
+		},
+	}
+	gwParams := &agentgateway.AgentgatewayParameters{
+		ObjectMeta: metav1.ObjectMeta{Name: gwParamsName, Namespace: namespace},
+		Spec: agentgateway.AgentgatewayParametersSpec{
+			AgentgatewayParametersConfigs: agentgateway.AgentgatewayParametersConfigs{
+				Env: []corev1.EnvVar{{Name: "LOG_LEVEL", Value: "debug"}},
+			},
+		},
+	}
+
+	fakeClient := fake.NewClient(t, overridden, defaulted, unsupported, gwc, classParams, gwParams)
+	inputs := &Inputs{
+		ImageDefaults: &agentgateway.Image{
+			Registry:   new("cr.agentgateway.dev"),
+			Repository: new("agentgateway"),
+			Tag:        new("latest"),
+		},
+		ControlPlane: ControlPlaneInfo{
+			XdsHost:    "agentgateway",
+			AgwXdsPort: 15000,
+		},
+		NoListenersDummyPort:       15021,
+		AgentgatewayClassName:      wellknown.DefaultAgwClassName,
+		AgentgatewayControllerName: wellknown.DefaultAgwControllerName,
+		AgwCollections: &agwplugins.AgwCollections{
+			ControllerName:      wellknown.DefaultAgwControllerName,
+			GatewaysForDeployer: krt.NewStaticCollection[collections.GatewayForDeployer](nil, nil),
+		},
+	}
+	gp := NewGatewayParameters(fakeClient, inputs).WithSessionKeyGenerator(func() (string, error) {
+		return "00112233445566778899aabbccddeeff00112233445566778899aabbccddeeff", nil
+	})
+	stop := test.NewStop(t)
+	fakeClient.RunAndWait(stop)
+	d, err := NewGatewayDeployer(
+		wellknown.DefaultAgwControllerName,
+		wellknown.DefaultAgwClassName,
+		schemes.DefaultScheme(),
+		fakeClient,
+		gp,
+	)
+	require.NoError(t, err)
+
+	envFor := func(t *testing.T, gw *gwv1.Gateway) map[string]string {
+		t.Helper()
+		objs, err := d.GetObjsToDeploy(context.Background(), gw)
+		require.NoError(t, err)
+		for _, obj := range objs {
+			if dep, ok := obj.(*appsv1.Deployment); ok {
+				require.NotEmpty(t, dep.Spec.Template.Spec.Containers)
+				env := map[string]string{}
+				for _, e := range dep.Spec.Template.Spec.Containers[0].Env {
+					env[e.Name] = e.Value
+				}
+				return env
+			}
+		}
+		t.Fatalf("no Deployment rendered for Gateway %s", gw.Name)
+		return nil
+	}
+
+	// The Gateway-level parameters override the class default for that Gateway only.
+	env := envFor(t, overridden)
+	assert.Equal(t, "debug", env["LOG_LEVEL"])
+	assert.Equal(t, "class", env["REGION"])
+
+	env = envFor(t, defaulted)
+	assert.Equal(t, "info", env["LOG_LEVEL"])
+	assert.Equal(t, "class", env["REGION"])
+
+	_, err = d.GetObjsToDeploy(context.Background(), unsupported)
+	require.ErrorContains(t, err, "infrastructure.parametersRef on Gateway default/unsupported references unsupported type: group=example.com")
+}
+
+func TestGetObjsToDeploy_PropagatesParametersMetadata(t *testing.T) {
+	const (
+		namespace       = "default"
+		classParamsName = "class-params"
+		gwParamsName    = "gateway-params"
+	)
+	paramsNamespace := gwv1.Namespace(namespace)
+	gw := &gwv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Name: "gw", Namespace: namespace},
+		Spec: gwv1.GatewaySpec{
+			GatewayClassName: gwv1.ObjectName(wellknown.DefaultAgwClassName),
+			Infrastructure: &gwv1.GatewayInfrastructure{
+				Labels: map[gwv1.LabelKey]gwv1.LabelValue{"team": "infra"},
+				ParametersRef: &gwv1.LocalParametersReference{
+					Group: agentgateway.GroupName,
+					Kind:  gwv1.Kind(wellknown.AgentgatewayParametersGVK.Kind),
+					Name:  gwParamsName,
+				},
+			},
+			Listeners: []gwv1.Listener{{
+				Name:     "http",
+				Protocol: gwv1.HTTPProtocolType,
+				Port:     8080,
+			}},
+		},
+	}
+	gw.SetGroupVersionKind(wellknown.GatewayGVK)
+	gwc := &gwv1.GatewayClass{
+		ObjectMeta: metav1.ObjectMeta{Name: wellknown.DefaultAgwClassName},
+		Spec: gwv1.GatewayClassSpec{
+			ControllerName: gwv1.GatewayController(wellknown.DefaultAgwControllerName),
+			ParametersRef: &gwv1.ParametersReference{
+				Group:     agentgateway.GroupName,
+				Kind:      gwv1.Kind(wellknown.AgentgatewayParametersGVK.Kind),
+				Name:      classParamsName,
+				Namespace: &paramsNamespace,
+			},
+		},
+	}
+	classParams := &agentgateway.AgentgatewayParameters{
+		ObjectMeta: metav1.ObjectMeta{Name: classParamsName, Namespace: namespace},
+		Spec: agentgateway.AgentgatewayParametersSpec{
+			AgentgatewayParametersConfigs: agentgateway.AgentgatewayParametersConfigs{
+				Labels:      map[string]string{"cost-center": "class", "team": "class"},
+				Annotations: map[string]string{"gitops.example.com/owner": "platform"},
+			},
+		},
+	}
+	gwParams := &agentgateway.AgentgatewayParameters{
+		ObjectMeta: metav1.ObjectMeta{Name: gwParamsName, Namespace: namespace},
+		Spec: agentgateway.AgentgatewayParametersSpec{
+			AgentgatewayParametersConfigs: agentgateway.AgentgatewayParametersConfigs{
+				Labels: map[string]string{
+					"cost-center":                            "gateway",
+					"app.kubernetes.io/name":                 "clobbered",
+					"app.kubernetes.io/managed-by":           "clobbered",
+					"gateway.networking.k8s.io/gateway-name": "clobbered",
+				},
+				Annotations: map[string]string{"checksum/config": "clobbered"},
+			},
+		},
+	}
+
+	fakeClient := fake.NewClient(t, gw, gwc, classParams, gwParams)
+	inputs := &Inputs{
+		ImageDefaults: &agentgateway.Image{
+			Registry:   new("cr.agentgateway.dev"),
+			Repository: new("agentgateway"),
+			Tag:        new("latest"),
+		},
+		ControlPlane: ControlPlaneInfo{
+			XdsHost:    "agentgateway",
+			AgwXdsPort: 15000,
+		},
+		NoListenersDummyPort:       15021,
+		AgentgatewayClassName:      wellknown.DefaultAgwClassName,
+		AgentgatewayControllerName: wellknown.DefaultAgwControllerName,
+		AgwCollections: &agwplugins.AgwCollections{
+			ControllerName:      wellknown.DefaultAgwControllerName,
+			GatewaysForDeployer: krt.NewStaticCollection[collections.GatewayForDeployer](nil, nil),
+		},
+	}
+	gp := NewGatewayParameters(fakeClient, inputs).WithSessionKeyGenerator(func() (string, error) {
+		return "00112233445566778899aabbccddeeff00112233445566778899aabbccddeeff", nil
+	})
+	stop := test.NewStop(t)
+	fakeClient.RunAndWait(stop)
+	d, err := NewGatewayDeployer(
+		wellknown.DefaultAgwControllerName,
+		wellknown.DefaultAgwClassName,
+		schemes.DefaultScheme(),
+		fakeClient,
+		gp,
+	)
+	require.NoError(t, err)
+
+	objs, err := d.GetObjsToDeploy(context.Background(), gw)
+	require.NoError(t, err)
+
+	checkMeta := func(t *testing.T, kind string, labels, annotations map[string]string) {
+		t.Helper()
+		assert.Equal(t, "gateway", labels["cost-center"], "%s: gateway params label should override class", kind)
+		assert.Equal(t, "infra", labels["team"], "%s: infrastructure label should override params", kind)
+		assert.Equal(t, "gw", labels["app.kubernetes.io/name"], "%s: managed label must not be clobbered", kind)
+		assert.Equal(t, "gw", labels["gateway.networking.k8s.io/gateway-name"], "%s: managed label must not be clobbered", kind)
+		assert.NotEqual(t, "clobbered", labels["app.kubernetes.io/managed-by"], "%s: managed label must not be clobbered", kind)
+		assert.Equal(t, "platform", annotations["gitops.example.com/owner"], "%s: class annotation should propagate", kind)
+		assert.NotContains(t, annotations, "checksum/config", "%s: managed annotation must not be set from params", kind)
+	}
+	var seen []string
+	for _, obj := range objs {
+		kind := obj.GetObjectKind().GroupVersionKind().Kind
+		switch o := obj.(type) {
+		case *appsv1.Deployment:
+			podAnnotations := maps.Clone(o.Spec.Template.Annotations)
+			assert.NotEqual(t, "clobbered", podAnnotations["checksum/config"], "Pod: managed annotation must not be clobbered")
+			delete(podAnnotations, "checksum/config")
+			checkMeta(t, "Pod", o.Spec.Template.Labels, podAnnotations)
+		case *corev1.Service, *corev1.ServiceAccount, *corev1.ConfigMap:
+		default:
+			continue
+		}
+		checkMeta(t, kind, obj.GetLabels(), obj.GetAnnotations())
+		seen = append(seen, kind)
+	}
+	assert.ElementsMatch(t, []string{"Deployment", "Service", "ServiceAccount", "ConfigMap"}, seen)
+}
+
+func TestGetObjsToDeploy_RendersServiceConfig(t *testing.T) {
+	const (
+		namespace       = "default"
+		classParamsName = "class-params"
+		gwParamsName    = "gateway-params"
+	)
+	paramsNamespace := gwv1.Namespace(namespace)
+	gw := &gwv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Name: "gw", Namespace: namespace},
+		Spec: gwv1.GatewaySpec{
+			GatewayClassName: gwv1.ObjectName(wellknown.DefaultAgwClassName),
+			Infrastructure: &gwv1.GatewayInfrastructure{
+				Annotations: map[gwv1.AnnotationKey]gwv1.AnnotationValue{"example.com/shared": "infra"},
+				ParametersRef: &gwv1.LocalParametersReference{
+					Group: agentgateway.GroupName,
+					Kind:  gwv1.Kind(wellknown.AgentgatewayParametersGVK.Kind),
+					Name:  gwParamsName,
+				},
+			},
+			Listeners: []gwv1.Listener{{
+				Name:     "http",
+				Protocol: gwv1.HTTPProtocolType,
+				Port:     8080,
+			}},
+		},
+	}
+	gw.SetGroupVersionKind(wellknown.GatewayGVK)
+	gwc := &gwv1.GatewayClass{
+		ObjectMeta: metav1.ObjectMeta{Name: wellknown.DefaultAgwClassName},
+		Spec: gwv1.GatewayClassSpec{
+			ControllerName: gwv1.GatewayController(wellknown.DefaultAgwControllerName),
+			ParametersRef: &gwv1.ParametersReference{
+				Group:     agentgateway.GroupName,
+				Kind:      gwv1.Kind(wellknown.AgentgatewayParametersGVK.Kind),
+				Name:      classParamsName,
+				Namespace: &paramsNamespace,
+			},
+		},
+	}
+	classParams := &agentgateway.AgentgatewayParameters{
+		ObjectMeta: metav1.ObjectMeta{Name: classParamsName, Namespace: namespace},
+		Spec: agentgateway.AgentgatewayParametersSpec{
+			AgentgatewayParametersConfigs: agentgateway.AgentgatewayParametersConfigs{
+				ServiceConfig: &agentgateway.AgentgatewayParametersServiceConfig{
+					Type:              new(corev1.ServiceTypeLoadBalancer),
+					LoadBalancerClass: new("example.com/internal"),
+					Annotations:       map[string]string{"example.com/lb-scheme": "internal"},
+				},
+			},
+		},
+	}
+	gwParams := &agentgateway.AgentgatewayParameters{
+		ObjectMeta: metav1.ObjectMeta{Name: gwParamsName, Namespace: namespace},
+		Spec: agentgateway.AgentgatewayParametersSpec{
+			AgentgatewayParametersConfigs: agentgateway.AgentgatewayParametersConfigs{
+				ServiceConfig: &agentgateway.AgentgatewayParametersServiceConfig{
+					Annotations: map[string]string{"example.com/shared": "service"},
+				},
+			},
+		},
+	}
+
+	fakeClient := fake.NewClient(t, gw, gwc, classParams, gwParams)
+	inputs := &Inputs{
+		ImageDefaults: &agentgateway.Image{
+			Registry:   new("cr.agentgateway.dev"),
+			Repository: new("agentgateway"),
+			Tag:        new("latest"),
+		},
+		ControlPlane: ControlPlaneInfo{
+			XdsHost:    "agentgateway",
+			AgwXdsPort: 15000,
+		},
+		NoListenersDummyPort:       15021,
+		AgentgatewayClassName:      wellknown.DefaultAgwClassName,
+		AgentgatewayControllerName: wellknown.DefaultAgwControllerName,
+		AgwCollections: &agwplugins.AgwCollections{
+			ControllerName:      wellknown.DefaultAgwControllerName,
+			GatewaysForDeployer: krt.NewStaticCollection[collections.GatewayForDeployer](nil, nil),
+		},
+	}
+	gp := NewGatewayParameters(fakeClient, inputs).WithSessionKeyGenerator(func() (string, error) {
+		return "00112233445566778899aabbccddeeff00112233445566778899aabbccddeeff", nil
+	})
+	stop := test.NewStop(t)
+	fakeClient.RunAndWait(stop)
+	d, err := NewGatewayDeployer(
+		wellknown.DefaultAgwControllerName,
+		wellknown.DefaultAgwClassName,
+		schemes.DefaultScheme(),
+		fakeClient,
+		gp,
+	)
+	require.NoError(t, err)
+
+	renderService := func(t require.TestingT) *corev1.Service {
+		objs, err := d.GetObjsToDeploy(context.Background(), gw)
+		require.NoError(t, err)
+		for _, obj := range objs {
+			if svc, ok := obj.(*corev1.Service); ok {
+				return svc
+			}
+		}
+		require.Fail(t, "no Service rendered")
+		return nil
+	}
+
+	svc := renderService(t)
+	assert.Equal(t, corev1.ServiceTypeLoadBalancer, svc.Spec.Type)
+	assert.Equal(t, new("example.com/internal"), svc.Spec.LoadBalancerClass)
+	assert.Equal(t, "internal", svc.Annotations["example.com/lb-scheme"])
+	assert.Equal(t, "service", svc.Annotations["example.com/shared"], "service annotations should override infrastructure annotations")
+
+	// Switch to ClusterIP; loadBalancerClass is cleared too since it is only valid for LoadBalancer.
+	classParams.Spec.ServiceConfig = &agentgateway.AgentgatewayParametersServiceConfig{
+		Annotations: map[string]string{"example.com/lb-scheme": "internal"},
+	}
+	gwParams.Spec.ServiceConfig = &agentgateway.AgentgatewayParametersServiceConfig{
+		Type:        new(corev1.ServiceTypeClusterIP),
+		Annotations: map[string]string{"example.com/lb-scheme": "none"},
+	}
+	paramsClient := kclient.New[*agentgateway.AgentgatewayParameters](fakeClient)
+	_, err = paramsClient.Update(classParams)
+	require.NoError(t, err)
+	_, err = paramsClient.Update(gwParams)
+	require.NoError(t, err)
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		svc := renderService(c)
+		assert.Equal(c, corev1.ServiceTypeClusterIP, svc.Spec.Type)
+		assert.Nil(c, svc.Spec.LoadBalancerClass)
+		assert.Equal(c, "none", svc.Annotations["example.com/lb-scheme"])
+		assert.Equal(c, "infra", svc.Annotations["example.com/shared"])
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestGetObjsToDeploy_RendersScheduling(t *testing.T) {
+	const (
+		namespace    = "default"
+		gwParamsName = "gateway-params"
+	)
+	gw := &gwv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Name: "gw", Namespace: namespace},
+		Spec: gwv1.GatewaySpec{
+			GatewayClassName: gwv1.ObjectName(wellknown.DefaultAgwClassName),
+			Infrastructure: &gwv1.GatewayInfrastructure{
+				ParametersRef: &gwv1.LocalParametersReference{
+					Group: agentgateway.GroupName,
+					Kind:  gwv1.Kind(wellknown.AgentgatewayParametersGVK.Kind),
+					Name:  gwParamsName,
+				},
+			},
+			Listeners: []gwv1.Listener{{
+				Name:     "http",
+				Protocol: gwv1.HTTPProtocolType,
+				Port:     8080,
+			}},
+		},
+	}
+	gw.SetGroupVersionKind(wellknown.GatewayGVK)
+	gwc := &gwv1.GatewayClass{
+		ObjectMeta: metav1.ObjectMeta{Name: wellknown.DefaultAgwClassName},
+		Spec: gwv1.GatewayClassSpec{
+			ControllerName: gwv1.GatewayController(wellknown.DefaultAgwControllerName),
+		},
+	}
+	tolerations := []corev1.Toleration{
+		{
+			Key:      "dedicated",
+			Operator: corev1.TolerationOpEqual,
+			Value:    "agentgateway",
+			Effect:   corev1.TaintEffectNoSchedule,
+		},
+		{
+			Key:               "node.kubernetes.io/unreachable",
+			Operator:          corev1.TolerationOpExists,
+			Effect:            corev1.TaintEffectNoExecute,
+			TolerationSeconds: new(int64(30)),
+		},
+	}
+	gwParams := &agentgateway.AgentgatewayParameters{
+		ObjectMeta: metav1.ObjectMeta{Name: gwParamsName, Namespace: namespace},
+		Spec: agentgateway.AgentgatewayParametersSpec{
+			AgentgatewayParametersConfigs: agentgateway.AgentgatewayParametersConfigs{
+				NodeSelector: map[string]string{"node-role.example.com/gateway": "true"},
+				Tolerations:  tolerations,
+			},
+		},
+	}
+
+	fakeClient := fake.NewClient(t, gw, gwc, gwParams)
+	inputs := &Inputs{
+		ImageDefaults: &agentgateway.Image{
+			Registry:   new("cr.agentgateway.dev"),
+			Repository: new("agentgateway"),
+			Tag:        new("latest"),
+		},
+		ControlPlane: ControlPlaneInfo{
+			XdsHost:    "agentgateway",
+			AgwXdsPort: 15000,
+		},
+		NoListenersDummyPort:       15021,
+		AgentgatewayClassName:      wellknown.DefaultAgwClassName,
+		AgentgatewayControllerName: wellknown.DefaultAgwControllerName,
+		AgwCollections: &agwplugins.AgwCollections{
+			ControllerName:      wellknown.DefaultAgwControllerName,
+			GatewaysForDeployer: krt.NewStaticCollection[collections.GatewayForDeployer](nil, nil),
+		},
+	}
+	gp := NewGatewayParameters(fakeClient, inputs).WithSessionKeyGenerator(func() (string, error) {
+		return "00112233445566778899aabbccddeeff00112233445566778899aabbccddeeff", nil
+	})
+	stop := test.NewStop(t)
+	fakeClient.RunAndWait(stop)
+	d, err := NewGatewayDeployer(
+		wellknown.DefaultAgwControllerName,
+		wellknown.DefaultAgwClassName,
+		schemes.DefaultScheme(),
+		fakeClient,
+		gp,
+	)
+	require.NoError(t, err)
+
+	renderPodSpec := func(t require.TestingT) corev1.PodSpec {
+		objs, err := d.GetObjsToDeploy(context.Background(), gw)
+		require.NoError(t, err)
+		for _, obj := range objs {
+			if dep, ok := obj.(*appsv1.Deployment); ok {
+				return dep.Spec.Template.Spec
+			}
+		}
+		require.Fail(t, "no Deployment rendered")
+		return corev1.PodSpec{}
+	}
+
+	podSpec := renderPodSpec(t)
+	assert.Equal(t, map[string]string{"node-role.example.com/gateway": "true"}, podSpec.NodeSelector)
+	assert.Equal(t, tolerations, podSpec.Tolerations)
+
+	// An unrelated change to the parameters re-renders the pod with the scheduling settings intact.
+	gwParams.Spec.Logging = &agentgateway.AgentgatewayParametersLogging{Level: "debug"}
+	_, err = kclient.New[*agentgateway.AgentgatewayParameters](fakeClient).Update(gwParams)
+	require.NoError(t, err)
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		podSpec := renderPodSpec(c)
+		require.Len(c, podSpec.Containers, 1)
+		assert.Contains(c, podSpec.Containers[0].Env, corev1.EnvVar{Name: "RUST_LOG", Value: "debug"})
+		assert.Equal(c, map[string]string{"node-role.example.com/gateway": "true"}, podSpec.NodeSelector)
+		assert.Equal(c, tolerations, podSpec.Tolerations)
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestAgentgatewayParametersApplier_ApplyToHelmValues_Scheduling(t *testing.T) {
+	classTolerations := []corev1.Toleration{{Key: "class", Operator: corev1.TolerationOpExists}}
+	gatewayTolerations := []corev1.Toleration{{Key: "gateway", Operator: corev1.TolerationOpExists}}
+	vals := &HelmConfig{Agentgateway: &AgentgatewayHelmGateway{}}
+
+	NewAgentgatewayParametersApplier(&agentgateway.AgentgatewayParameters{
+		Spec: agentgateway.AgentgatewayParametersSpec{
+			AgentgatewayParametersConfigs: agentgateway.AgentgatewayParametersConfigs{
+				NodeSelector: map[string]string{"pool": "class", "zone": "a"},
+				Tolerations:  classTolerations,
+			},
+		},
+	}).ApplyToHelmValues(vals)
+	NewAgentgatewayParametersApplier(&agentgateway.AgentgatewayParameters{
+		Spec: agentgateway.AgentgatewayParametersSpec{
+			AgentgatewayParametersConfigs: agentgateway.AgentgatewayParametersConfigs{
+				NodeSelector: map[string]string{"pool": "gateway"},
+			},
+		},
+	}).ApplyToHelmValues(vals)
+	assert.Equal(t, map[string]string{"pool": "gateway", "zone": "a"}, vals.Agentgateway.NodeSelector)
+	assert.Equal(t, classTolerations, vals.Agentgateway.Tolerations, "unset tolerations should inherit the class defaults")
+
+	NewAgentgatewayParametersApplier(&agentgateway.AgentgatewayParameters{
+		Spec: agentgateway.AgentgatewayParametersSpec{
+			AgentgatewayParametersConfigs: agentgateway.AgentgatewayParametersConfigs{
+				Tolerations: gatewayTolerations,
+			},
+		},
+	}).ApplyToHelmValues(vals)
+	assert.Equal(t, gatewayTolerations, vals.Agentgateway.Tolerations)
+}
+
+func TestAgentgatewayParametersApplier_ApplyToHelmValues_Admin(t *testing.T) {
+	vals := &HelmConfig{Agentgateway: &AgentgatewayHelmGateway{}}
+	NewAgentgatewayParametersApplier(&agentgateway.AgentgatewayParameters{}).ApplyToHelmValues(vals)
+	assert.Nil(t, vals.Agentgateway.Admin, "the admin server should not be configured unless requested")
+
+	NewAgentgatewayParametersApplier(&agentgateway.AgentgatewayParameters{
+		Spec: agentgateway.AgentgatewayParametersSpec{
+			AgentgatewayParametersConfigs: agentgateway.AgentgatewayParametersConfigs{
+				Admin: &agentgateway.AgentgatewayParametersAdmin{Port: new(int32(9901))},
+			},
+		},
+	}).ApplyToHelmValues(vals)
+	NewAgentgatewayParametersApplier(&agentgateway.AgentgatewayParameters{
+		Spec: agentgateway.AgentgatewayParametersSpec{
+			AgentgatewayParametersConfigs: agentgateway.AgentgatewayParametersConfigs{
+				Admin: &agentgateway.AgentgatewayParametersAdmin{},
+			},
+		},
+	}).ApplyToHelmValues(vals)
+	require.NotNil(t, vals.Agentgateway.Admin)
+	assert.Equal(t, int32(9901), *vals.Agentgateway.Admin.Port, "unset port should inherit the class value")
+}
+
+func TestValidateAdmin(t *testing.T) {
+	ports := []HelmPort{{Port: new(int32(8080))}, {Port: new(int32(15000))}}
+	assert.NoError(t, validateAdmin(&AgentgatewayHelmGateway{Ports: ports}), "admin disabled should not be checked")
+
+	err := validateAdmin(&AgentgatewayHelmGateway{
+		Ports:                         ports,
+		AgentgatewayParametersConfigs: agentgateway.AgentgatewayParametersConfigs{Admin: &agentgateway.AgentgatewayParametersAdmin{}},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "admin port 15000 is already used by a Gateway listener")
+
+	assert.NoError(t, validateAdmin(&AgentgatewayHelmGateway{
+		Ports: ports,
+		AgentgatewayParametersConfigs: agentgateway.AgentgatewayParametersConfigs{
+			Admin: &agentgateway.AgentgatewayParametersAdmin{Port: new(int32(9901))},
+		},
+	}))
+}
+
+func TestAgentgatewayParametersApplier_ApplyToHelmValues_ServiceAccount(t *testing.T) {
+	vals := &HelmConfig{Agentgateway: &AgentgatewayHelmGateway{}}
+	NewAgentgatewayParametersApplier(&agentgateway.AgentgatewayParameters{}).ApplyToHelmValues(vals)
+	assert.Nil(t, vals.Agentgateway.ServiceAccountName, "the managed service account should be used by default")
+	assert.Empty(t, vals.Agentgateway.ImagePullSecrets)
+
+	NewAgentgatewayParametersApplier(&agentgateway.AgentgatewayParameters{
+		Spec: agentgateway.AgentgatewayParametersSpec{
+			AgentgatewayParametersConfigs: agentgateway.AgentgatewayParametersConfigs{
+				ServiceAccountName: new("class-proxy"),
+				ImagePullSecrets:   []corev1.LocalObjectReference{{Name: "class-creds"}},
+			},
+		},
+	}).ApplyToHelmValues(vals)
+	NewAgentgatewayParametersApplier(&agentgateway.AgentgatewayParameters{
+		Spec: agentgateway.AgentgatewayParametersSpec{
+			AgentgatewayParametersConfigs: agentgateway.AgentgatewayParametersConfigs{
+				ImagePullSecrets: []corev1.LocalObjectReference{{Name: "gateway-creds"}},
+			},
+		},
+	}).ApplyToHelmValues(vals)
+	assert.Equal(t, new("class-proxy"), vals.Agentgateway.ServiceAccountName)
+	assert.Equal(t, []corev1.LocalObjectReference{{Name: "gateway-creds"}}, vals.Agentgateway.ImagePullSecrets)
+}
+
+func TestResolvedParametersServiceAccountName(t *testing.T) {
+	withServiceAccount := func(name *string) *agentgateway.AgentgatewayParameters {
+		return &agentgateway.AgentgatewayParameters{
+			Spec: agentgateway.AgentgatewayParametersSpec{
+				AgentgatewayParametersConfigs: agentgateway.AgentgatewayParametersConfigs{ServiceAccountName: name},
+			},
+		}
+	}
+
+	assert.Equal(t, "gw", (&resolvedParameters{}).serviceAccountName("gw"), "the managed service account is named after the Gateway")
+	assert.Equal(t, "class-proxy", (&resolvedParameters{
+		gatewayClassAGWP: withServiceAccount(new("class-proxy")),
+		gatewayAGWP:      withServiceAccount(nil),
+	}).serviceAccountName("gw"))
+	assert.Equal(t, "gateway-proxy", (&resolvedParameters{
+		gatewayClassAGWP: withServiceAccount(new("class-proxy")),
+		gatewayAGWP:      withServiceAccount(new("gateway-proxy")),
+	}).serviceAccountName("gw"))
+}
+
+func TestGetObjsToDeploy_PodDisruptionBudgetConfig(t *testing.T) {
+	const (
+		namespace    = "default"
+		gwParamsName = "gateway-params"
+	)
+	gw := &gwv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Name: "gw", Namespace: namespace, UID: "gateway-uid"},
+		Spec: gwv1.GatewaySpec{
+			GatewayClassName: gwv1.ObjectName(wellknown.DefaultAgwClassName),
+			Infrastructure: &gwv1.GatewayInfrastructure{
+				ParametersRef: &gwv1.LocalParametersReference{
+					Group: agentgateway.GroupName,
+					Kind:  gwv1.Kind(wellknown.AgentgatewayParametersGVK.Kind),
+					Name:  gwParamsName,
+				},
+			},
+			Listeners: []gwv1.Listener{{
+				Name:     "http",
+				Protocol: gwv1.HTTPProtocolType,
+				Port:     8080,
+			}},
+		},
+	}
+	gw.SetGroupVersionKind(wellknown.GatewayGVK)
+	gwc := &gwv1.GatewayClass{
+		ObjectMeta: metav1.ObjectMeta{Name: wellknown.DefaultAgwClassName},
+		Spec: gwv1.GatewayClassSpec{
+			ControllerName: gwv1.GatewayController(wellknown.DefaultAgwControllerName),
+		},
+	}
+	gwParams := &agentgateway.AgentgatewayParameters{
+		ObjectMeta: metav1.ObjectMeta{Name: gwParamsName, Namespace: namespace},
+		Spec: agentgateway.AgentgatewayParametersSpec{
+			AgentgatewayParametersConfigs: agentgateway.AgentgatewayParametersConfigs{
+				PodDisruptionBudgetConfig: &agentgateway.AgentgatewayParametersPodDisruptionBudgetConfig{
+					MaxUnavailable: new(intstr.FromString("25%")),
+				},
+			},
+			AgentgatewayParametersOverlays: agentgateway.AgentgatewayParametersOverlays{
+				PodDisruptionBudget: &agentgateway.KubernetesResourceOverlay{
+					Metadata: &agentgateway.ObjectMetadata{
+						Labels: map[string]string{"overlay": "applied"},
+					},
+				},
+			},
+		},
+	}
+
+	fakeClient := fake.NewClient(t, gw, gwc, gwParams)
+	inputs := &Inputs{
+		ImageDefaults: &agentgateway.Image{
+			Registry:   new("cr.agentgateway.dev"),
+			Repository: new("agentgateway"),
+			Tag:        new("latest"),
+		},
+		ControlPlane: ControlPlaneInfo{
+			XdsHost:    "agentgateway",
+			AgwXdsPort: 15000,
+		},
+		NoListenersDummyPort:       15021,
+		AgentgatewayClassName:      wellknown.DefaultAgwClassName,
+		AgentgatewayControllerName: wellknown.DefaultAgwControllerName,
+		AgwCollections: &agwplugins.AgwCollections{
+			ControllerName:      wellknown.DefaultAgwControllerName,
+			GatewaysForDeployer: krt.NewStaticCollection[collections.GatewayForDeployer](nil, nil),
+		},
+	}
+	gp := NewGatewayParameters(fakeClient, inputs).WithSessionKeyGenerator(func() (string, error) {
+		return "00112233445566778899aabbccddeeff00112233445566778899aabbccddeeff", nil
+	})
+	stop := test.NewStop(t)
+	fakeClient.RunAndWait(stop)
+	d, err := NewGatewayDeployer(
+		wellknown.DefaultAgwControllerName,
+		wellknown.DefaultAgwClassName,
+		schemes.DefaultScheme(),
+		fakeClient,
+		gp,
+	)
+	require.NoError(t, err)
+
+	objs, err := d.GetObjsToDeploy(context.Background(), gw)
+	require.NoError(t, err)
+	objs = d.SetNamespaceAndOwnerWithGVK(gw, wellknown.GatewayGVK, objs)
+	pdbs := podDisruptionBudgetsFromDeployObjects(objs)
+	require.Len(t, pdbs, 1, "the overlay should patch the configured PDB rather than add another")
+	pdb := pdbs[0]
+	assert.Equal(t, "gw", pdb.Name)
+	assert.Nil(t, pdb.Spec.MinAvailable)
+	assert.Equal(t, new(intstr.FromString("25%")), pdb.Spec.MaxUnavailable)
+	require.NotNil(t, pdb.Spec.Selector)
+	assert.Equal(t, "gw", pdb.Spec.Selector.MatchLabels["app.kubernetes.io/name"])
+	assert.Equal(t, "applied", pdb.Labels["overlay"])
+	require.NotEmpty(t, pdb.OwnerReferences)
+
+	// Stand in for the deployed PDB; the fake client does not support server-side apply creates.
+	pdbGVR, err := wellknown.GVKToGVR(wellknown.PodDisruptionBudgetGVK)
+	require.NoError(t, err)
+	deployed, err := kubeutils.ToUnstructured(pdb)
+	require.NoError(t, err)
+	_, err = fakeClient.Dynamic().Resource(pdbGVR).Namespace(namespace).Create(context.Background(), deployed, metav1.CreateOptions{})
+	require.NoError(t, err)
+	listPDBs := func() []string {
+		list, err := fakeClient.Dynamic().Resource(pdbGVR).Namespace(namespace).List(context.Background(), metav1.ListOptions{})
+		require.NoError(t, err)
+		var names []string
+		for _, item := range list.Items {
+			names = append(names, item.GetName())
+		}
+		return names
+	}
+	assert.Equal(t, []string{"gw"}, listPDBs())
+
+	// Removing the config (and the overlay) drops the PDB from the desired set and prunes it.
+	gwParams.Spec.PodDisruptionBudgetConfig = nil
+	gwParams.Spec.PodDisruptionBudget = nil
+	_, err = kclient.New[*agentgateway.AgentgatewayParameters](fakeClient).Update(gwParams)
+	require.NoError(t, err)
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		objs, err = d.GetObjsToDeploy(context.Background(), gw)
+		require.NoError(c, err)
+		assert.Empty(c, podDisruptionBudgetsFromDeployObjects(objs))
+	}, time.Second, 10*time.Millisecond)
+	require.NoError(t, d.PruneRemovedResources(context.Background(), gw, objs))
+	assert.Empty(t, listPDBs())
+}
+
+func TestValidateExtraContainers(t *testing.T) {
+	apply := func(vals *HelmConfig, configs agentgateway.AgentgatewayParametersConfigs) {
+		NewAgentgatewayParametersApplier(&agentgateway.AgentgatewayParameters{
+			Spec: agentgateway.AgentgatewayParametersSpec{AgentgatewayParametersConfigs: configs},
+		}).ApplyToHelmValues(vals)
+	}
+
+	t.Run("gateway layer replaces class containers", func(t *testing.T) {
+		vals := &HelmConfig{Agentgateway: &AgentgatewayHelmGateway{}}
+		apply(vals, agentgateway.AgentgatewayParametersConfigs{
+			InitContainers: []corev1.Container{{Name: "fetch-secrets"}},
+			Sidecars:       []corev1.Container{{Name: "class-logger"}},
+		})
+		apply(vals, agentgateway.AgentgatewayParametersConfigs{
+			Sidecars: []corev1.Container{{Name: "gateway-logger"}},
+		})
+		assert.Equal(t, []corev1.Container{{Name: "fetch-secrets"}}, vals.Agentgateway.InitContainers)
+		assert.Equal(t, []corev1.Container{{Name: "gateway-logger"}}, vals.Agentgateway.Sidecars)
+		assert.NoError(t, validateExtraContainers(vals.Agentgateway))
+	})
+
+	t.Run("collision across layers is rejected", func(t *testing.T) {
+		vals := &HelmConfig{Agentgateway: &AgentgatewayHelmGateway{}}
+		apply(vals, agentgateway.AgentgatewayParametersConfigs{
+			InitContainers: []corev1.Container{{Name: "helper"}},
+		})
+		apply(vals, agentgateway.AgentgatewayParametersConfigs{
+			Sidecars: []corev1.Container{{Name: "helper"}},
+		})
+		err := validateExtraContainers(vals.Agentgateway)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `container name "helper" is already in use`)
+	})
+
+	t.Run("proxy container cannot be replaced", func(t *testing.T) {
+		vals := &HelmConfig{Agentgateway: &AgentgatewayHelmGateway{}}
+		apply(vals, agentgateway.AgentgatewayParametersConfigs{
+			Sidecars: []corev1.Container{{Name: "agentgateway"}},
+		})
+		err := validateExtraContainers(vals.Agentgateway)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `container name "agentgateway" is already in use`)
+	})
+}
+
 func TestAgentgatewayParametersApplier_ApplyToHelmValues_RawConfig(t *testing.T) {
 	rawConfigJSON := []byte(`{
 		"tracing": {
@@ -789,6 +1600,101 @@ func TestAgentgatewayParametersApplier_ApplyToHelmValues_NoAliasing(t *testing.T
 		"cached GatewayClass Requests must remain nil")
 }
 
+// TestAgentgatewayParametersApplier_ApplyToHelmValues_GatewayClassDefaults verifies
+// that GatewayClass AGWP values act as defaults: a Gateway AGWP that only sets some
+// fields overrides those fields and leaves everything else from the GatewayClass.
+func TestAgentgatewayParametersApplier_ApplyToHelmValues_GatewayClassDefaults(t *testing.T) {
+	gatewayClassAGWP := &agentgateway.AgentgatewayParameters{
+		Spec: agentgateway.AgentgatewayParametersSpec{
+			AgentgatewayParametersConfigs: agentgateway.AgentgatewayParametersConfigs{
+				Image: &agentgateway.Image{
+					Registry: ptr.To("registry.example.com"),
+					Tag:      ptr.To("v1.0.0"),
+				},
+				Logging: &agentgateway.AgentgatewayParametersLogging{
+					Level:  "debug",
+					Format: agentgateway.AgentgatewayParametersLoggingJson,
+				},
+				Env: []corev1.EnvVar{
+					{Name: "FROM_CLASS", Value: "class"},
+					{Name: "SHARED", Value: "class"},
+				},
+				RawConfig: &apiextensionsv1.JSON{Raw: []byte(`{
+					"tracing": {"otlpEndpoint": "http://jaeger:4317", "randomSampling": 0.5},
+					"config": {"workerThreads": 1000000}
+				}`)},
+			},
+		},
+	}
+	gatewayAGWP := &agentgateway.AgentgatewayParameters{
+		Spec: agentgateway.AgentgatewayParametersSpec{
+			AgentgatewayParametersConfigs: agentgateway.AgentgatewayParametersConfigs{
+				Image: &agentgateway.Image{
+					Tag: ptr.To("v2.0.0"),
+				},
+				Logging: &agentgateway.AgentgatewayParametersLogging{
+					Format: agentgateway.AgentgatewayParametersLoggingText,
+				},
+				Env: []corev1.EnvVar{
+					{Name: "SHARED", Value: "gateway"},
+				},
+				RawConfig: &apiextensionsv1.JSON{Raw: []byte(`{
+					"tracing": {"randomSampling": 1}
+				}`)},
+			},
+		},
+	}
+	origGWC := gatewayClassAGWP.DeepCopy()
+
+	vals := &HelmConfig{
+		Agentgateway: &AgentgatewayHelmGateway{},
+	}
+	NewAgentgatewayParametersApplier(gatewayClassAGWP).ApplyToHelmValues(vals)
+	NewAgentgatewayParametersApplier(gatewayAGWP).ApplyToHelmValues(vals)
+
+	got := vals.Agentgateway
+	require.NotNil(t, got.Image)
+	assert.Equal(t, "registry.example.com", *got.Image.Registry, "class-only registry must survive")
+	assert.Equal(t, "v2.0.0", *got.Image.Tag)
+	require.NotNil(t, got.Logging)
+	assert.Equal(t, "debug", got.Logging.Level, "class-only log level must survive")
+	assert.Equal(t, agentgateway.AgentgatewayParametersLoggingText, got.Logging.Format)
+	assert.Equal(t, []corev1.EnvVar{
+		{Name: "FROM_CLASS", Value: "class"},
+		{Name: "SHARED", Value: "gateway"},
+	}, got.Env)
+	require.NotNil(t, got.RawConfig)
+	assert.JSONEq(t, `{
+		"tracing": {"otlpEndpoint": "http://jaeger:4317", "randomSampling": 1},
+		"config": {"workerThreads": 1000000}
+	}`, string(got.RawConfig.Raw))
+	assert.Contains(t, string(got.RawConfig.Raw), "1000000", "numbers must round-trip unchanged")
+
+	assert.Equal(t, origGWC, gatewayClassAGWP, "cached GatewayClass AGWP must not be mutated")
+}
+
+func TestAgentgatewayParametersApplier_ApplyToHelmValues_RawConfigNotAnObject(t *testing.T) {
+	vals := &HelmConfig{Agentgateway: &AgentgatewayHelmGateway{}}
+	NewAgentgatewayParametersApplier(&agentgateway.AgentgatewayParameters{
+		Spec: agentgateway.AgentgatewayParametersSpec{
+			AgentgatewayParametersConfigs: agentgateway.AgentgatewayParametersConfigs{
+				RawConfig: &apiextensionsv1.JSON{Raw: []byte(`["config"]`)},
+			},
+		},
+	}).ApplyToHelmValues(vals)
+	NewAgentgatewayParametersApplier(&agentgateway.AgentgatewayParameters{
+		Spec: agentgateway.AgentgatewayParametersSpec{
+			AgentgatewayParametersConfigs: agentgateway.AgentgatewayParametersConfigs{
+				RawConfig: &apiextensionsv1.JSON{Raw: []byte(`{"config": {"workerThreads": 2}}`)},
+			},
+		},
+	}).ApplyToHelmValues(vals)
+
+	err := validateTracingEndpoint(vals.Agentgateway.RawConfig)
+	require.Error(t, err, "an undecodable GatewayClass rawConfig must not be dropped silently")
+	assert.Contains(t, err.Error(), "invalid rawConfig: must be a JSON object")
+}
+
 func TestAgentgatewayParametersApplier_ApplyToHelmValues_RawConfigWithLogging(t *testing.T) {
 	// rawConfig has logging.format, but typed Logging.Format should take precedence
 	// (merging happens in helm template, but here we test both are passed through)
@@ -824,6 +1730,84 @@ func TestAgentgatewayParametersApplier_ApplyToHelmValues_RawConfigWithLogging(t
 	assert.Equal(t, vals.Agentgateway.RawConfig.Raw, rawConfigJSON)
 }
 
+func TestValidateTracingSecretHeaders(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "vendor-tracing",
+			Namespace: "default",
+		},
+		Data: map[string][]byte{
+			"api-key": []byte("s3cr3t"),
+			"list":    []byte("a,b"),
+		},
+	}
+	generator := &agentgatewayParametersHelmValuesGenerator{
+		secretClient: newSyncedSecretClient(t, secret),
+	}
+	gatewayWith := func(secretName, key string, env ...corev1.EnvVar) *AgentgatewayHelmGateway {
+		return &AgentgatewayHelmGateway{
+			AgentgatewayParametersConfigs: agentgateway.AgentgatewayParametersConfigs{
+				Env: env,
+				Tracing: &agentgateway.AgentgatewayParametersTracing{
+					SecretHeaders: []agentgateway.AgentgatewayParametersTracingSecretHeader{{
+						Name: "x-api-key",
+						SecretKeyRef: agentgateway.AgentgatewayParametersSecretKeySelector{
+							Name: secretName,
+							Key:  key,
+						},
+					}},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		gtw     *AgentgatewayHelmGateway
+		wantErr string
+	}{
+		{
+			name: "no tracing headers",
+			gtw:  &AgentgatewayHelmGateway{},
+		},
+		{
+			name: "existing secret key",
+			gtw:  gatewayWith("vendor-tracing", "api-key"),
+		},
+		{
+			name:    "missing secret",
+			gtw:     gatewayWith("other", "api-key"),
+			wantErr: `tracing secret header "x-api-key" references Secret default/other, which does not exist`,
+		},
+		{
+			name:    "missing key",
+			gtw:     gatewayWith("vendor-tracing", "token"),
+			wantErr: `references key "token", which is missing from Secret default/vendor-tracing`,
+		},
+		{
+			name:    "value with comma",
+			gtw:     gatewayWith("vendor-tracing", "list"),
+			wantErr: "must not contain commas",
+		},
+		{
+			name:    "conflicting env",
+			gtw:     gatewayWith("vendor-tracing", "api-key", corev1.EnvVar{Name: "OTLP_HEADERS", Value: "a=b"}),
+			wantErr: "env OTLP_HEADERS cannot be set together with tracing.secretHeaders",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := generator.validateTracingSecretHeaders("default", tt.gtw)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
 func TestBuildSessionKeySecret_UsesExistingValidKey(t *testing.T) {
 	const existingKey = "00112233445566778899aabbccddeeff00112233445566778899aabbccddeeff"
 	secret := &corev1.Secret{
@@ -930,3 +1914,164 @@ func horizontalPodAutoscalersFromDeployObjects(objs []client.Object) []*autoscal
 	}
 	return hpas
 }
+
+func TestValidateImage(t *testing.T) {
+	tests := []struct {
+		name    string
+		img     *agentgateway.Image
+		wantErr string
+	}{
+		{
+			name: "default image",
+			img:  &agentgateway.Image{Registry: new("cr.agentgateway.dev"), Repository: new("agentgateway"), Tag: new("1.0.0")},
+		},
+		{
+			name: "mirror registry with port and path",
+			img:  &agentgateway.Image{Registry: new("mirror.internal:5000/agentgateway"), Repository: new("agentgateway"), Tag: new("1.0.0")},
+		},
+		{
+			name: "digest without tag",
+			img: &agentgateway.Image{
+				Registry:   new("cr.agentgateway.dev"),
+				Repository: new("agentgateway"),
+				Tag:        new(""),
+				Digest:     new("sha256:fd697c0542524e19a19baf75acfd06714b95bf4f7c60bf22c86164dd647ceb48"),
+			},
+		},
+		{
+			name:    "missing repository",
+			img:     &agentgateway.Image{Registry: new("cr.agentgateway.dev"), Repository: new("")},
+			wantErr: "an image repository must be set",
+		},
+		{
+			name:    "invalid digest",
+			img:     &agentgateway.Image{Repository: new("agentgateway"), Digest: new("sha256:abc")},
+			wantErr: `invalid image "agentgateway@sha256:abc"`,
+		},
+		{
+			name: "name too long once merged",
+			img: &agentgateway.Image{
+				Registry:   new("registry.example.com/" + strings.Repeat("a", 200)),
+				Repository: new(strings.Repeat("b", 200)),
+			},
+			wantErr: "repository name must not be more than 255 characters",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateImage(tt.img)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestValidateTracingEndpoint(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		wantErr string
+	}{
+		{
+			name: "no rawConfig tracing",
+			raw:  `{"config": {"logging": {"format": "json"}}}`,
+		},
+		{
+			name: "http endpoint with port",
+			raw:  `{"config": {"tracing": {"otlpEndpoint": "http://jaeger.observability:4317"}}}`,
+		},
+		{
+			name: "https endpoint with path",
+			raw:  `{"config": {"tracing": {"otlpEndpoint": "https://otel.example.com/v1/traces"}}}`,
+		},
+		{
+			name: "ipv6 endpoint",
+			raw:  `{"config": {"tracing": {"otlpEndpoint": "http://[::1]:4317"}}}`,
+		},
+		{
+			name:    "missing scheme",
+			raw:     `{"config": {"tracing": {"otlpEndpoint": "jaeger:4317"}}}`,
+			wantErr: "scheme must be http or https",
+		},
+		{
+			name:    "unsupported scheme",
+			raw:     `{"config": {"tracing": {"otlpEndpoint": "grpc://jaeger:4317"}}}`,
+			wantErr: "scheme must be http or https",
+		},
+		{
+			name:    "missing host",
+			raw:     `{"config": {"tracing": {"otlpEndpoint": "http://:4317"}}}`,
+			wantErr: "a host must be set",
+		},
+		{
+			name:    "port out of range",
+			raw:     `{"config": {"tracing": {"otlpEndpoint": "http://jaeger:99999"}}}`,
+			wantErr: "port must be between 1 and 65535",
+		},
+		{
+			name:    "malformed port",
+			raw:     `{"config": {"tracing": {"otlpEndpoint": "http://jaeger:43a7"}}}`,
+			wantErr: `invalid rawConfig config.tracing.otlpEndpoint "http://jaeger:43a7"`,
+		},
+		{
+			name:    "not a string",
+			raw:     `{"config": {"tracing": {"otlpEndpoint": 4317}}}`,
+			wantErr: "must be a string",
+		},
+		{
+			name:    "not an object",
+			raw:     `"config"`,
+			wantErr: "invalid rawConfig: must be a JSON object",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateTracingEndpoint(&apiextensionsv1.JSON{Raw: []byte(tt.raw)})
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestAgentgatewayParametersApplier_ApplyToHelmValues_SecurityContext(t *testing.T) {
+	vals := &HelmConfig{Agentgateway: &AgentgatewayHelmGateway{
+		AgentgatewayParametersConfigs: agentgateway.AgentgatewayParametersConfigs{
+			PodSecurityContext: defaultPodSecurityContext(),
+			SecurityContext:    defaultSecurityContext(),
+		},
+	}}
+	NewAgentgatewayParametersApplier(&agentgateway.AgentgatewayParameters{
+		Spec: agentgateway.AgentgatewayParametersSpec{
+			AgentgatewayParametersConfigs: agentgateway.AgentgatewayParametersConfigs{
+				PodSecurityContext: &corev1.PodSecurityContext{
+					Sysctls:        []corev1.Sysctl{{Name: "net.ipv4.ip_unprivileged_port_start", Value: "80"}},
+					SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+				},
+				SecurityContext: &corev1.SecurityContext{
+					ReadOnlyRootFilesystem: new(false),
+					Capabilities:           &corev1.Capabilities{Drop: []corev1.Capability{}},
+				},
+			},
+		},
+	}).ApplyToHelmValues(vals)
+
+	pod := vals.Agentgateway.PodSecurityContext
+	assert.Equal(t, []corev1.Sysctl{{Name: "net.ipv4.ip_unprivileged_port_start", Value: "80"}}, pod.Sysctls,
+		"sysctls should be merged by name")
+	assert.Equal(t, corev1.SeccompProfileTypeRuntimeDefault, pod.SeccompProfile.Type)
+
+	c := vals.Agentgateway.SecurityContext
+	assert.Equal(t, new(false), c.ReadOnlyRootFilesystem)
+	assert.Empty(t, c.Capabilities.Drop, "an explicit empty drop list should clear the default")
+	assert.Equal(t, new(false), c.AllowPrivilegeEscalation, "unset fields should keep their defaults")
+	assert.Equal(t, new(true), c.RunAsNonRoot)
+	assert.Equal(t, new(int64(10101)), c.RunAsUser)
+}
@@ -391,6 +391,7 @@ func (d *Deployer) PruneRemovedResources(ctx context.Context, owner client.Objec
 		wellknown.PodDisruptionBudgetGVK,
 		wellknown.HorizontalPodAutoscalerGVK,
 		wellknown.VerticalPodAutoscalerGVK,
+		wellknown.ServiceAccountGVK,
 	}
 
 	var pruningErrors []error
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"maps"
+	"slices"
 
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
@@ -229,7 +230,18 @@ func appendSupportResources(
 	}
 
 	if hasPodDisruptionBudgetOverlay(layers) {
-		pdb := client.Object(createPodDisruptionBudget(workload))
+		// A PDB rendered from podDisruptionBudgetConfig is patched in place; otherwise
+		// the overlay creates one.
+		idx := slices.IndexFunc(objs, func(obj client.Object) bool {
+			_, ok := obj.(*policyv1.PodDisruptionBudget)
+			return ok
+		})
+		var pdb client.Object
+		if idx >= 0 {
+			pdb = objs[idx]
+		} else {
+			pdb = createPodDisruptionBudget(workload)
+		}
 		for _, layer := range layers {
 			if layer.PodDisruptionBudget == nil {
 				continue
@@ -244,7 +256,11 @@ func appendSupportResources(
 			}
 			pdb = patched
 		}
-		objs = append(objs, pdb)
+		if idx >= 0 {
+			objs[idx] = pdb
+		} else {
+			objs = append(objs, pdb)
+		}
 	}
 
 	if hasHorizontalPodAutoscalerOverlay(layers) {
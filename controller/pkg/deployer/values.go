@@ -42,7 +42,10 @@ type HelmEndpointPickerExtension struct {
 }
 
 type AgentgatewayHelmService struct {
-	LoadBalancerIP *string `json:"loadBalancerIP,omitempty"`
+	Type              *string           `json:"type,omitempty"`
+	LoadBalancerClass *string           `json:"loadBalancerClass,omitempty"`
+	LoadBalancerIP    *string           `json:"loadBalancerIP,omitempty"`
+	Annotations       map[string]string `json:"annotations,omitempty"`
 }
 
 type AgentgatewayHelmGateway struct {
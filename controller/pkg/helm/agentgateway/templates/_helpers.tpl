@@ -108,25 +108,40 @@ metadata:
       ((hasKey $gateway "istio") | ternary (dict "istio.io/dataplane-mode" "none" "sidecar.istio.io/inject" "false") (dict))
     ) | nindent 4 }}
 spec:
-  serviceAccountName: {{ include "kgateway.gateway.fullname" . }}
+  serviceAccountName: {{ $gateway.serviceAccountName | default (include "kgateway.gateway.fullname" .) }}
+  {{- with $gateway.priorityClassName }}
+  priorityClassName: {{ . }}
+  {{- end }}
+  {{- with $gateway.imagePullSecrets }}
+  imagePullSecrets:
+    {{- toYaml . | nindent 4 }}
+  {{- end }}
+  {{- with $gateway.nodeSelector }}
+  nodeSelector:
+    {{- toYaml . | nindent 4 }}
+  {{- end }}
+  {{- with $gateway.tolerations }}
+  tolerations:
+    {{- toYaml . | nindent 4 }}
+  {{- end }}
+  {{- with $gateway.initContainers }}
+  initContainers:
+    {{- toYaml . | nindent 4 }}
+  {{- end }}
+  {{- with $gateway.podSecurityContext }}
   securityContext:
-    sysctls:
-      - name: net.ipv4.ip_unprivileged_port_start
-        value: "0"
+    {{- toYaml . | nindent 4 }}
+  {{- end }}
   containers:
     - name: agentgateway
       image: "{{ template "kgateway.gateway.image" $gateway.image }}"
       {{- if $gateway.image.pullPolicy }}
       imagePullPolicy: {{ $gateway.image.pullPolicy | quote }}
       {{- end }}
+      {{- with $gateway.securityContext }}
       securityContext:
-        allowPrivilegeEscalation: false
-        capabilities:
-          drop:
-            - ALL
-        readOnlyRootFilesystem: true
-        runAsNonRoot: true
-        runAsUser: 10101
+        {{- toYaml . | nindent 8 }}
+      {{- end }}
       readinessProbe:
         httpGet:
           path: /healthz/ready
@@ -271,6 +286,20 @@ spec:
           value: {{ $catalogPaths | join "," | quote }}
         {{- end }}
       {{- end }}
+      {{- /* Tracing header values stay in their Secrets; OTLP_HEADERS refers to them via $(VAR) expansion */}}
+      {{- with ($gateway.tracing).secretHeaders }}
+      {{- $pairs := list }}
+      {{- range $i, $h := . }}
+        - name: {{ printf "AGW_TRACING_HEADER_%d" $i }}
+          valueFrom:
+            secretKeyRef:
+              name: {{ $h.secretKeyRef.name | quote }}
+              key: {{ $h.secretKeyRef.key | quote }}
+      {{- $pairs = append $pairs (printf "%s=$(AGW_TRACING_HEADER_%d)" $h.name $i) }}
+      {{- end }}
+        - name: OTLP_HEADERS
+          value: {{ $pairs | join "," | quote }}
+      {{- end }}
       {{- /* User-specified env vars */}}
       {{- with $gateway.env }}
         {{- toYaml . | nindent 8 }}
@@ -304,6 +333,9 @@ spec:
           readOnly: true
         {{- end }}
         {{- end }}
+    {{- with $gateway.sidecars }}
+    {{- toYaml . | nindent 4 }}
+    {{- end }}
   volumes:
     - name: config-volume
       configMap:
@@ -9,6 +9,8 @@ const (
 	PolicyAcceptedMsg = "Policy accepted"
 
 	PolicyAttachedMsg = "Attached to all targets"
+
+	PolicyProgrammedMsg = "Policy sent to the data plane"
 )
 
 type PolicyCondition struct {
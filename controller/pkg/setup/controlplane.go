@@ -16,6 +16,7 @@ import (
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/reflection"
 	"istio.io/istio/pkg/security"
+	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/agentgateway/agentgateway/controller/pkg/metrics"
 	"github.com/agentgateway/agentgateway/controller/pkg/syncer/krtxds"
@@ -60,6 +61,7 @@ func runXDSServer(
 	xdsAuth bool,
 	certProvider certificateProvider,
 	nackPublisher *nack.Publisher,
+	gatewayServiceAccount func(gateway types.NamespacedName) (types.NamespacedName, bool),
 	reg ...krtxds.Registration,
 ) {
 	baseLogger := slog.Default().With("component", "agentgateway-controlplane")
@@ -68,6 +70,7 @@ func runXDSServer(
 	grpcServer := grpc.NewServer(serverOpts...)
 
 	ds := krtxds.NewDiscoveryServer(nil, nackPublisher, reg...)
+	ds.GatewayServiceAccount = gatewayServiceAccount
 	stop := make(chan struct{})
 	context.AfterFunc(ctx, func() {
 		close(stop)
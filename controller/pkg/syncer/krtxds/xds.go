@@ -265,6 +265,10 @@ type DiscoveryServer struct {
 	// pushQueue is the buffer that used after debounce and before the real xds push.
 	pushQueue *PushQueue
 
+	// GatewayServiceAccount, if set, returns the service account the data plane of a gateway
+	// runs as. It is used to authorize gateways whose service account is not named after them.
+	GatewayServiceAccount func(gateway types.NamespacedName) (types.NamespacedName, bool)
+
 	// debugHandlers is the list of all the supported debug handlers.
 	debugHandlers map[string]string
 
@@ -833,9 +837,22 @@ func (s *DiscoveryServer) authenticate(ctx context.Context) *types.NamespacedNam
 	}
 	return &types.NamespacedName{
 		Namespace: peer.KubernetesInfo.PodNamespace,
-		// We assume the SA and gateway name are the same
-		Name: peer.KubernetesInfo.PodServiceAccount,
+		Name:      peer.KubernetesInfo.PodServiceAccount,
+	}
+}
+
+// authorized reports whether a client authenticated as serviceAccount may receive config for the
+// requested gateway. The service account must be the one the gateway's data plane runs as. That is
+// the serviceAccountName from the gateway's AgentgatewayParameters when set, and otherwise the
+// managed service account named after the gateway.
+func (s *DiscoveryServer) authorized(requested, serviceAccount types.NamespacedName) bool {
+	expected := requested
+	if s.GatewayServiceAccount != nil {
+		if sa, ok := s.GatewayServiceAccount(requested); ok {
+			expected = sa
+		}
 	}
+	return serviceAccount == expected
 }
 
 func (s *DiscoveryServer) ProxyNeedsPush(proxy *Proxy, request *PushRequest) bool {
@@ -877,7 +894,7 @@ func (s *DiscoveryServer) initConnection(node *envoycorev3.Node, con *Connection
 	// Authorize xds clients
 	if id != nil {
 		reqId := AgentgatewayID(con.node)
-		if reqId != *id {
+		if !s.authorized(reqId, *id) {
 			return fmt.Errorf("requested gateway %v but authenticated as %v", reqId, *id)
 		}
 	}
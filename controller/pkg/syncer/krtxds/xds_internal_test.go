@@ -1,6 +1,10 @@
 package krtxds
 
-import "testing"
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+)
 
 func TestParseNackDiagnosticsStructuredJSON(t *testing.T) {
 	message := `[{"key":"bind/default","warn":"cipher skipped"},{"key":"route/default","error":"invalid backend"}]`
@@ -25,3 +29,74 @@ func TestParseNackDiagnosticsFallsBackForLegacyMessage(t *testing.T) {
 		t.Fatalf("expected legacy message to skip structured parsing, got %#v", diagnostics)
 	}
 }
+
+func TestAuthorized(t *testing.T) {
+	gw := types.NamespacedName{Namespace: "default", Name: "gw"}
+	// gw runs as the custom service account "proxy" set in its AgentgatewayParameters.
+	customServiceAccount := func(gateway types.NamespacedName) (types.NamespacedName, bool) {
+		if gateway == gw {
+			return types.NamespacedName{Namespace: gateway.Namespace, Name: "proxy"}, true
+		}
+		return types.NamespacedName{}, false
+	}
+	tests := []struct {
+		name                  string
+		serviceAccount        types.NamespacedName
+		gatewayServiceAccount func(types.NamespacedName) (types.NamespacedName, bool)
+		want                  bool
+	}{
+		{
+			name:           "managed service account without lookup",
+			serviceAccount: gw,
+			want:           true,
+		},
+		{
+			name:           "other service account without lookup",
+			serviceAccount: types.NamespacedName{Namespace: "default", Name: "proxy"},
+			want:           false,
+		},
+		{
+			name:                  "custom service account from parameters",
+			serviceAccount:        types.NamespacedName{Namespace: "default", Name: "proxy"},
+			gatewayServiceAccount: customServiceAccount,
+			want:                  true,
+		},
+		{
+			// The managed service account is no longer used once a custom one is configured.
+			name:                  "managed service account when a custom one is configured",
+			serviceAccount:        gw,
+			gatewayServiceAccount: customServiceAccount,
+			want:                  false,
+		},
+		{
+			// A pod labeled with the gateway name but running as a foreign service account is rejected;
+			// pod labels are never trusted for authorization.
+			name:                  "foreign service account with forged gateway label",
+			serviceAccount:        types.NamespacedName{Namespace: "default", Name: "attacker"},
+			gatewayServiceAccount: customServiceAccount,
+			want:                  false,
+		},
+		{
+			name:                  "custom service account in another namespace",
+			serviceAccount:        types.NamespacedName{Namespace: "other", Name: "proxy"},
+			gatewayServiceAccount: customServiceAccount,
+			want:                  false,
+		},
+		{
+			name:           "unknown gateway falls back to the managed service account",
+			serviceAccount: gw,
+			gatewayServiceAccount: func(types.NamespacedName) (types.NamespacedName, bool) {
+				return types.NamespacedName{}, false
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &DiscoveryServer{GatewayServiceAccount: tt.gatewayServiceAccount}
+			if got := s.authorized(gw, tt.serviceAccount); got != tt.want {
+				t.Fatalf("authorized() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
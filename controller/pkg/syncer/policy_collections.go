@@ -42,8 +42,10 @@ func BuildPolicies(agwPlugins plugins.AgwPlugin, references plugins.ReferenceInd
 		}
 	}
 	joinPolicies := krt.JoinCollection(allPolicies, krtopts.ToOptions("policies/All")...)
+	// Keys must be unique per Gateway across every plugin, so collisions are resolved over the joined policies.
+	resolvedPolicies, policyStatusMap := plugins.PolicyKeyCollisions(joinPolicies, policyStatusMap, krtopts)
 
-	allPoliciesCol := krt.NewCollection(joinPolicies, func(ctx krt.HandlerContext, i plugins.AgwPolicy) *ir.AgwResource {
+	allPoliciesCol := krt.NewCollection(resolvedPolicies, func(ctx krt.HandlerContext, i plugins.AgwPolicy) *ir.AgwResource {
 		return new(translator.ToResourceForGateway(*i.Gateway, i))
 	}, krtopts.ToOptions("resources/Policies")...)
 
@@ -6,6 +6,7 @@ package status
 import (
 	"context"
 	"sync"
+	"time"
 
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
@@ -125,10 +126,16 @@ func (p *WorkQueue) Length() int {
 	return len(p.queue)
 }
 
-func NewWorkerPool(ctx context.Context, work func(ctx context.Context, resource Resource, data any), maxWorkers uint) *WorkerPool {
+// NewWorkerPool creates a WorkerPool running work for each pushed resource. When batchDelay is non-zero,
+// a resource is held for batchDelay after its first Push, and further pushes for the same object in that
+// window, including ones for newer resource versions, replace the held data. Rapid changes to an object
+// are then written once, no later than batchDelay after the first change.
+func NewWorkerPool(ctx context.Context, work func(ctx context.Context, resource Resource, data any), maxWorkers uint, batchDelay time.Duration) *WorkerPool {
 	wp := &WorkerPool{
 		work:       work,
 		maxWorkers: maxWorkers,
+		batchDelay: batchDelay,
+		batches:    make(map[batchKey]*batchedPush),
 		ctx:        ctx,
 		q: WorkQueue{
 			pending:    make(map[Resource]any),
@@ -153,12 +160,54 @@ type WorkerPool struct {
 	workerCount uint
 	// maximum worker routine count
 	maxWorkers uint
-	lock       sync.Mutex
-	ctx        context.Context
+	// how long pushes for an object are held to coalesce them
+	batchDelay time.Duration
+	// pushes held until their batch delay elapses, keyed by object rather than resource version
+	batches map[batchKey]*batchedPush
+	lock    sync.Mutex
+	ctx     context.Context
+}
+
+type batchKey struct {
+	schema.GroupVersionKind
+	types.NamespacedName
+}
+
+type batchedPush struct {
+	target Resource
+	data   any
 }
 
 func (wp *WorkerPool) Push(target Resource, data any) {
-	wp.q.Enqueue(target, data)
+	if wp.batchDelay <= 0 {
+		wp.q.Enqueue(target, data)
+		wp.maybeAddWorker()
+		return
+	}
+
+	key := batchKey{GroupVersionKind: target.GroupVersionKind, NamespacedName: target.NamespacedName}
+	wp.lock.Lock()
+	defer wp.lock.Unlock()
+	if b, f := wp.batches[key]; f {
+		// A flush is already scheduled; it will pick up the latest push.
+		b.target, b.data = target, data
+		return
+	}
+	wp.batches[key] = &batchedPush{target: target, data: data}
+	time.AfterFunc(wp.batchDelay, func() { wp.flush(key) })
+}
+
+// flush moves a held push onto the work queue.
+func (wp *WorkerPool) flush(key batchKey) {
+	wp.lock.Lock()
+	b := wp.batches[key]
+	delete(wp.batches, key)
+	closing := wp.closing
+	wp.lock.Unlock()
+	if b == nil || closing {
+		return
+	}
+	wp.q.Enqueue(b.target, b.data)
 	wp.maybeAddWorker()
 }
 
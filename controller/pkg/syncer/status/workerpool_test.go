@@ -0,0 +1,129 @@
+package status
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+type recordedWrite struct {
+	resource Resource
+	data     any
+	at       time.Time
+}
+
+type writeRecorder struct {
+	mu     sync.Mutex
+	writes []recordedWrite
+}
+
+func (r *writeRecorder) work(_ context.Context, resource Resource, data any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.writes = append(r.writes, recordedWrite{resource: resource, data: data, at: time.Now()})
+}
+
+func (r *writeRecorder) snapshot() []recordedWrite {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]recordedWrite(nil), r.writes...)
+}
+
+// waitForWrites waits until the recorder has seen want writes for name, failing after timeout.
+func (r *writeRecorder) waitForWrites(t *testing.T, name string, want int, timeout time.Duration) []recordedWrite {
+	t.Helper()
+	deadline := time.Now().Add(timeout)
+	for {
+		var got []recordedWrite
+		for _, w := range r.snapshot() {
+			if w.resource.Name == name {
+				got = append(got, w)
+			}
+		}
+		if len(got) >= want {
+			return got
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected %d writes for %s within %v, got %d", want, name, timeout, len(got))
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func testResource(name string, version int) Resource {
+	return Resource{
+		GroupVersionKind: schema.GroupVersionKind{Group: "agentgateway.dev", Version: "v1alpha1", Kind: "AgentgatewayPolicy"},
+		NamespacedName:   types.NamespacedName{Namespace: "default", Name: name},
+		ResourceVersion:  strconv.Itoa(version),
+	}
+}
+
+func TestWorkerPoolBatchesRapidPushes(t *testing.T) {
+	const (
+		pushes     = 50
+		batchDelay = 50 * time.Millisecond
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	rec := &writeRecorder{}
+	wp := NewWorkerPool(ctx, rec.work, 10, batchDelay)
+
+	start := time.Now()
+	for i := range pushes {
+		// Each change bumps the resource version, as a spec update would.
+		wp.Push(testResource("policy", i), i)
+	}
+	writes := rec.waitForWrites(t, "policy", 1, time.Second)
+	// Give any straggling writes a chance to land before counting.
+	time.Sleep(2 * batchDelay)
+	writes = rec.waitForWrites(t, "policy", len(writes), time.Second)
+
+	if len(writes) >= pushes {
+		t.Fatalf("expected fewer than %d writes, got %d", pushes, len(writes))
+	}
+	last := writes[len(writes)-1]
+	if last.data != pushes-1 || last.resource.ResourceVersion != strconv.Itoa(pushes-1) {
+		t.Fatalf("expected final write to carry the latest push, got %v at version %s", last.data, last.resource.ResourceVersion)
+	}
+	// The bound is batchDelay; allow generous slack for scheduling on loaded machines.
+	if elapsed := writes[0].at.Sub(start); elapsed > batchDelay+500*time.Millisecond {
+		t.Fatalf("expected first write within %v, took %v", batchDelay, elapsed)
+	}
+}
+
+func TestWorkerPoolBatchesPerResource(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	rec := &writeRecorder{}
+	wp := NewWorkerPool(ctx, rec.work, 10, 20*time.Millisecond)
+
+	wp.Push(testResource("a", 1), "a1")
+	wp.Push(testResource("b", 1), "b1")
+	wp.Push(testResource("a", 2), "a2")
+
+	a := rec.waitForWrites(t, "a", 1, time.Second)
+	b := rec.waitForWrites(t, "b", 1, time.Second)
+	if a[len(a)-1].data != "a2" {
+		t.Fatalf("expected latest data for a, got %v", a[len(a)-1].data)
+	}
+	if b[len(b)-1].data != "b1" {
+		t.Fatalf("expected data for b, got %v", b[len(b)-1].data)
+	}
+}
+
+func TestWorkerPoolWithoutBatchDelayWritesEachVersion(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	rec := &writeRecorder{}
+	wp := NewWorkerPool(ctx, rec.work, 10, 0)
+
+	for i := range 3 {
+		wp.Push(testResource("policy", i), i)
+		rec.waitForWrites(t, "policy", i+1, time.Second)
+	}
+}
@@ -10,9 +10,11 @@ import (
 	"istio.io/istio/pkg/kube/controllers"
 	"istio.io/istio/pkg/kube/kclient"
 	"istio.io/istio/pkg/slices"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/conversion"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/tools/cache"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -21,6 +23,7 @@ import (
 
 	"github.com/agentgateway/agentgateway/controller/api/v1alpha1/agentgateway"
 	"github.com/agentgateway/agentgateway/controller/pkg/apiclient"
+	"github.com/agentgateway/agentgateway/controller/pkg/metrics"
 	"github.com/agentgateway/agentgateway/controller/pkg/syncer/status"
 	"github.com/agentgateway/agentgateway/controller/pkg/wellknown"
 )
@@ -32,8 +35,24 @@ const (
 	maxRetryAttempts = 5
 	retryDelay       = 100 * time.Millisecond
 
+	// statusBatchDelay bounds how long a status write is held so that rapid
+	// changes to the same resource are coalesced into a single write.
+	statusBatchDelay = 250 * time.Millisecond
+
 	// Log message keys
 	logKeyError = "error"
+
+	statusSubsystem = "status"
+	kindLabel       = "kind"
+)
+
+var statusSyncErrorsTotal = metrics.NewCounter(
+	metrics.CounterOpts{
+		Subsystem: statusSubsystem,
+		Name:      "sync_errors_total",
+		Help:      "Total number of status updates that failed after all retries",
+	},
+	[]string{kindLabel},
 )
 
 // AgentGwStatusSyncer runs only on the leader and syncs the status of agent gateway resources.
@@ -280,7 +299,7 @@ func (s *AgentGwStatusSyncer) SyncStatus(ctx context.Context, resource status.Re
 }
 
 func (s *AgentGwStatusSyncer) NewStatusWorker(ctx context.Context) *status.WorkerPool {
-	return status.NewWorkerPool(ctx, s.SyncStatus, 100)
+	return status.NewWorkerPool(ctx, s.SyncStatus, 100, statusBatchDelay)
 }
 
 type ResourceStatusSyncer interface {
@@ -390,11 +409,16 @@ func (s StatusSyncer[O, S]) ApplyStatus(ctx context.Context, obj status.Resource
 		// Passing Spec is ignored by the API server but has costs.
 		// Passing ResourceVersion is important to ensure we are not writing stale data. The collection is responsible for
 		// re-enqueuing a resource if it ends up being rejected due to stale ResourceVersion.
-		_, err := s.Client.UpdateStatus(s.Build(metav1.ObjectMeta{
+		desired := s.Build(metav1.ObjectMeta{
 			Name:            obj.Name,
 			Namespace:       obj.Namespace,
 			ResourceVersion: rv,
-		}, merged))
+		}, merged)
+		if statusUnchanged(current, desired) {
+			logger.Debug("status unchanged, skipping status update")
+			return nil
+		}
+		_, err := s.Client.UpdateStatus(desired)
 		if err != nil {
 			if apierrors.IsConflict(err) {
 				// This is normal. It is expected the collection will re-enqueue the write
@@ -415,11 +439,53 @@ func (s StatusSyncer[O, S]) ApplyStatus(ctx context.Context, obj status.Resource
 
 	if err != nil {
 		logger.Error("failed to sync status after retries", logKeyError, err, "policy", obj.NamespacedName.String())
+		statusSyncErrorsTotal.Inc(metrics.Label{Name: kindLabel, Value: obj.Kind})
 	} else {
 		logger.Debug("updated policy status")
 	}
 }
 
+// statusEquality is equality.Semantic, except that condition transition times are ignored. They are stamped on
+// every translation and would otherwise make an otherwise identical status look changed.
+var statusEquality = func() conversion.Equalities {
+	e := equality.Semantic.Copy()
+	if err := e.AddFunc(func(a, b metav1.Condition) bool {
+		a.LastTransitionTime, b.LastTransitionTime = metav1.Time{}, metav1.Time{}
+		return a == b
+	}); err != nil {
+		panic(err)
+	}
+	return e
+}()
+
+// statusUnchanged reports whether desired carries the same Status as current, so the write can be skipped.
+func statusUnchanged[O controllers.ComparableObject](current, desired O) bool {
+	switch cur := any(current).(type) {
+	case *agentgateway.AgentgatewayPolicy:
+		return statusEquality.DeepEqual(cur.Status, any(desired).(*agentgateway.AgentgatewayPolicy).Status)
+	case *agentgateway.AgentgatewayBackend:
+		return statusEquality.DeepEqual(cur.Status, any(desired).(*agentgateway.AgentgatewayBackend).Status)
+	case *gwv1.ListenerSet:
+		return statusEquality.DeepEqual(cur.Status, any(desired).(*gwv1.ListenerSet).Status)
+	case *gwv1.Gateway:
+		return statusEquality.DeepEqual(cur.Status, any(desired).(*gwv1.Gateway).Status)
+	case *gwv1.HTTPRoute:
+		return statusEquality.DeepEqual(cur.Status, any(desired).(*gwv1.HTTPRoute).Status)
+	case *gwv1.GRPCRoute:
+		return statusEquality.DeepEqual(cur.Status, any(desired).(*gwv1.GRPCRoute).Status)
+	case *gwv1.TCPRoute:
+		return statusEquality.DeepEqual(cur.Status, any(desired).(*gwv1.TCPRoute).Status)
+	case *gwv1.TLSRoute:
+		return statusEquality.DeepEqual(cur.Status, any(desired).(*gwv1.TLSRoute).Status)
+	case *gwv1.BackendTLSPolicy:
+		return statusEquality.DeepEqual(cur.Status, any(desired).(*gwv1.BackendTLSPolicy).Status)
+	case *inf.InferencePool:
+		return statusEquality.DeepEqual(cur.Status, any(desired).(*inf.InferencePool).Status)
+	default:
+		return false
+	}
+}
+
 func mergePolicyAncestorStatuses(ourControllerName string, existing []gwv1.PolicyAncestorStatus, desired []gwv1.PolicyAncestorStatus) []gwv1.PolicyAncestorStatus {
 	out := make([]gwv1.PolicyAncestorStatus, 0, len(existing)+len(desired))
 
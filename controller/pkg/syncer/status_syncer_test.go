@@ -1,12 +1,22 @@
 package syncer
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"istio.io/istio/pkg/kube"
+	"istio.io/istio/pkg/kube/controllers"
+	"istio.io/istio/pkg/kube/kclient"
+	"istio.io/istio/pkg/test"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/agentgateway/agentgateway/controller/pkg/apiclient/fake"
+	"github.com/agentgateway/agentgateway/controller/pkg/syncer/status"
+	"github.com/agentgateway/agentgateway/controller/pkg/wellknown"
 )
 
 func TestMergePolicyAncestorStatuses_SortsOurEntriesOnly(t *testing.T) {
@@ -273,3 +283,66 @@ func gatewayProgrammedCondition(status metav1.ConditionStatus, reason gwv1.Gatew
 		Message:            "programmed message",
 	}
 }
+
+type countingStatusClient[O controllers.ComparableObject] struct {
+	kclient.Client[O]
+	updates int
+}
+
+func (c *countingStatusClient[O]) UpdateStatus(object O) (O, error) {
+	c.updates++
+	return c.Client.UpdateStatus(object)
+}
+
+func TestApplyStatus_SkipsWriteWhenOnlyTransitionTimeDiffers(t *testing.T) {
+	our := "agentgateway.dev/agentgateway"
+	parent := func(reason gwv1.RouteConditionReason, transition metav1.Time) gwv1.RouteParentStatus {
+		return gwv1.RouteParentStatus{
+			ControllerName: gwv1.GatewayController(our),
+			ParentRef:      gwv1.ParentReference{Name: "gw"},
+			Conditions: []metav1.Condition{{
+				Type:               string(gwv1.RouteConditionAccepted),
+				Status:             metav1.ConditionTrue,
+				Reason:             string(reason),
+				LastTransitionTime: transition,
+			}},
+		}
+	}
+	route := &gwv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: "route", Namespace: "default"},
+		Status: gwv1.HTTPRouteStatus{RouteStatus: gwv1.RouteStatus{
+			Parents: []gwv1.RouteParentStatus{parent(gwv1.RouteReasonAccepted, metav1.Unix(1000, 0))},
+		}},
+	}
+	fakeClient := fake.NewClient(t, route)
+	client := &countingStatusClient[*gwv1.HTTPRoute]{Client: kclient.New[*gwv1.HTTPRoute](fakeClient)}
+	stop := test.NewStop(t)
+	fakeClient.RunAndWait(stop)
+	kube.WaitForCacheSync("test-status-syncer", stop, client.HasSynced)
+
+	syncer := StatusSyncer[*gwv1.HTTPRoute, *gwv1.HTTPRouteStatus]{
+		Name:           "httpRoute",
+		ControllerName: our,
+		Client:         client,
+		Build: func(om metav1.ObjectMeta, s *gwv1.HTTPRouteStatus) *gwv1.HTTPRoute {
+			return &gwv1.HTTPRoute{ObjectMeta: om, Status: *s}
+		},
+	}
+	res := status.Resource{
+		GroupVersionKind: wellknown.HTTPRouteGVK,
+		NamespacedName:   types.NamespacedName{Name: route.Name, Namespace: route.Namespace},
+	}
+	desired := func(reason gwv1.RouteConditionReason) *gwv1.HTTPRouteStatus {
+		return &gwv1.HTTPRouteStatus{RouteStatus: gwv1.RouteStatus{
+			Parents: []gwv1.RouteParentStatus{parent(reason, metav1.Now())},
+		}}
+	}
+
+	// Only the transition time differs, so nothing is written.
+	syncer.ApplyStatus(context.Background(), res, desired(gwv1.RouteReasonAccepted))
+	require.Equal(t, 0, client.updates)
+
+	// A real change is still written.
+	syncer.ApplyStatus(context.Background(), res, desired(gwv1.RouteReasonPending))
+	require.Equal(t, 1, client.updates)
+}
@@ -96,6 +96,16 @@ wIDAQABMA0GCSqGSIb3DQEBCwUAA4IBAQBtestcertdata
 					"session key Secret should use the opaque Secret type")
 				assert.Contains(t, outputYaml, "checksum/session-key: 2a8abfa8cb9906290437854193ca6bca41d4d4e26d1d454bd66a35158095e737",
 					"deployment pod template should roll when the managed session key changes")
+				assert.Contains(t, outputYaml, "image: cr.agentgateway.dev/agentgateway:99.99.99",
+					"proxy should use the default image when none is configured")
+				assert.NotContains(t, outputYaml, "imagePullPolicy:",
+					"pull policy should be left to Kubernetes defaults when unset")
+				assert.NotContains(t, outputYaml, "seccompProfile:",
+					"no seccomp profile should be set unless configured")
+				assert.Contains(t, outputYaml, "readOnlyRootFilesystem: true",
+					"proxy should use a read-only root filesystem by default")
+				assert.Contains(t, outputYaml, "runAsNonRoot: true",
+					"proxy should run as non-root by default")
 			},
 		},
 		{
@@ -125,6 +135,23 @@ wIDAQABMA0GCSqGSIb3DQEBCwUAA4IBAQBtestcertdata
 		{
 			Name:      "agentgateway with full image override",
 			InputFile: "agentgateway-image-override",
+			Validate: func(t *testing.T, outputYaml string) {
+				t.Helper()
+				assert.Contains(t, outputYaml, "image: my-custom-registry.io/my-org/custom-agentgateway:v2.0.0@sha256:abcdef1234567890abcdef1234567890abcdef1234567890abcdef1234567890",
+					"all image fields should be overridden")
+				assert.Contains(t, outputYaml, "imagePullPolicy: Always")
+			},
+		},
+		{
+			Name:      "agentgateway with image mirror and gateway pull policy",
+			InputFile: "agentgateway-image-mirror",
+			Validate: func(t *testing.T, outputYaml string) {
+				t.Helper()
+				assert.Contains(t, outputYaml, "image: mirror.internal:5000/agentgateway/agentgateway:99.99.99",
+					"unset image fields should fall back to the defaults")
+				assert.Contains(t, outputYaml, "imagePullPolicy: Never",
+					"gateway pull policy should override the class pull policy")
+			},
 		},
 		{
 			Name:      "agentgateway with env vars",
@@ -243,6 +270,80 @@ wIDAQABMA0GCSqGSIb3DQEBCwUAA4IBAQBtestcertdata
 			Name:      "agentgateway with logging format json",
 			InputFile: "agentgateway-logging-format",
 		},
+		{
+			Name:      "agentgateway with per-module logging level",
+			InputFile: "agentgateway-logging-level",
+			Validate: func(t *testing.T, outputYaml string) {
+				t.Helper()
+				assert.Contains(t, outputYaml, "- name: RUST_LOG\n          value: info,agentgateway::proxy=debug\n",
+					"logging.level should be rendered as RUST_LOG")
+				assert.Contains(t, outputYaml, "      logging:\n        format: json\n",
+					"logging.format should be rendered into the ConfigMap")
+			},
+		},
+		{
+			Name:      "agentgateway with admin endpoint on localhost",
+			InputFile: "agentgateway-admin",
+			Validate: func(t *testing.T, outputYaml string) {
+				t.Helper()
+				assert.Contains(t, outputYaml, "      adminAddr: localhost:15000\n",
+					"admin server should bind to localhost by default")
+				assert.NotContains(t, outputYaml, "name: admin",
+					"the admin server must not be exposed as a container port")
+			},
+		},
+		{
+			Name:      "agentgateway with admin endpoint on a custom port",
+			InputFile: "agentgateway-admin-port",
+			Validate: func(t *testing.T, outputYaml string) {
+				t.Helper()
+				assert.Contains(t, outputYaml, "      adminAddr: localhost:9901\n",
+					"admin server should stay on localhost with a custom port")
+				assert.NotContains(t, outputYaml, "name: admin",
+					"the admin server must not be exposed as a container port")
+			},
+		},
+		{
+			Name:      "agentgateway with tracing headers from a Secret",
+			InputFile: "agentgateway-tracing-secret-headers",
+			Validate: func(t *testing.T, outputYaml string) {
+				t.Helper()
+				assert.Contains(t, outputYaml, "- name: AGW_TRACING_HEADER_0\n          valueFrom:\n            secretKeyRef:\n              key: api-key\n              name: vendor-tracing\n",
+					"header value should be sourced from the Secret")
+				assert.Contains(t, outputYaml, "- name: OTLP_HEADERS\n          value: x-api-key=$(AGW_TRACING_HEADER_0),x-tenant=$(AGW_TRACING_HEADER_1)\n",
+					"OTLP_HEADERS should reference the Secret-backed env vars")
+				assert.NotContains(t, outputYaml, "s3cr3t-api-key",
+					"header value must not be rendered into any object")
+				assert.NotContains(t, outputYaml, "x-api-key:",
+					"header should not be written into the config ConfigMap")
+			},
+		},
+		{
+			Name:      "agentgateway with init containers and sidecars",
+			InputFile: "agentgateway-extra-containers",
+		},
+		{
+			Name:      "agentgateway with custom service account and image pull secrets",
+			InputFile: "agentgateway-custom-service-account",
+			Validate: func(t *testing.T, outputYaml string) {
+				t.Helper()
+				assert.Contains(t, outputYaml, "serviceAccountName: gw-proxy",
+					"pods should run as the custom service account")
+				assert.NotContains(t, outputYaml, "kind: ServiceAccount",
+					"no managed service account should be rendered when a custom one is set")
+				assert.Contains(t, outputYaml, "imagePullSecrets:\n      - name: registry-creds\n      - name: mirror-creds\n",
+					"image pull secrets should be set on the pod spec")
+			},
+		},
+		{
+			Name:      "agentgateway with priority class",
+			InputFile: "agentgateway-priority-class",
+			Validate: func(t *testing.T, outputYaml string) {
+				t.Helper()
+				assert.Contains(t, outputYaml, "      priorityClassName: system-cluster-critical\n",
+					"priority class should be set on the pod spec")
+			},
+		},
 		{
 			Name:      "agentgateway yaml injection",
 			InputFile: "agentgateway-yaml-injection",
@@ -399,6 +500,29 @@ wIDAQABMA0GCSqGSIb3DQEBCwUAA4IBAQBtestcertdata
 					"PDB should have minAvailable from overlay spec")
 			},
 		},
+		{
+			Name:      "agentgateway with replicas and rollout strategy",
+			InputFile: "agentgateway-rollout-strategy",
+			Validate: func(t *testing.T, outputYaml string) {
+				t.Helper()
+				assert.Contains(t, outputYaml, "replicas: 3",
+					"deployment should use the configured replica count")
+				assert.Contains(t, outputYaml, "strategy:\n    rollingUpdate:\n      maxSurge: 1\n      maxUnavailable: 0\n    type: RollingUpdate\n",
+					"deployment should use the configured rollout strategy")
+			},
+		},
+		{
+			Name:      "agentgateway with rollout strategy and HorizontalPodAutoscaler",
+			InputFile: "agentgateway-rollout-strategy-hpa",
+			Validate: func(t *testing.T, outputYaml string) {
+				t.Helper()
+				assert.Contains(t, outputYaml, "kind: HorizontalPodAutoscaler")
+				assert.NotContains(t, outputYaml, "  replicas: 3",
+					"replicas should be left to the HorizontalPodAutoscaler")
+				assert.Contains(t, outputYaml, "maxSurge: 25%",
+					"rollout strategy should still apply with an autoscaler")
+			},
+		},
 		{
 			Name:      "agentgateway with HorizontalPodAutoscaler overlay",
 			InputFile: "agentgateway-hpa-overlay",
@@ -540,6 +664,23 @@ wIDAQABMA0GCSqGSIb3DQEBCwUAA4IBAQBtestcertdata
 					"pod security context should have fsGroup")
 			},
 		},
+		{
+			Name:      "agentgateway with security context overrides",
+			InputFile: "agentgateway-security-context-overrides",
+			Validate: func(t *testing.T, outputYaml string) {
+				t.Helper()
+				assert.Contains(t, outputYaml, "fsGroup: 20000")
+				assert.Contains(t, outputYaml, "name: net.ipv4.ip_unprivileged_port_start",
+					"default sysctls should be kept when adding sysctls")
+				assert.Contains(t, outputYaml, "name: net.ipv4.ping_group_range")
+				assert.Contains(t, outputYaml, "seccompProfile:\n          type: RuntimeDefault",
+					"a configured seccomp profile should be rendered")
+				assert.Contains(t, outputYaml, "capabilities:\n            add:\n            - NET_BIND_SERVICE\n            drop:\n            - ALL\n",
+					"adding capabilities should keep the default drop list")
+				assert.Contains(t, outputYaml, "runAsUser: 20000")
+				assert.Contains(t, outputYaml, "readOnlyRootFilesystem: true")
+			},
+		},
 
 		// TLS test cases
 		{
@@ -44,6 +44,7 @@ pub struct TCPCall {
 	pub source: Socket,
 	pub target: Target,
 	pub transport: Transport,
+	pub idle_timeout: Option<Duration>,
 }
 
 #[derive(Default, Debug, Clone, Hash, PartialEq, Eq)]
@@ -539,6 +540,7 @@ impl Client {
 			source,
 			target,
 			transport,
+			idle_timeout,
 		} = call;
 
 		let dest = self
@@ -567,9 +569,20 @@ impl Client {
 			.await
 			.map_err(ProxyError::UpstreamTCPCallFailed)?;
 
-		agent_core::copy::copy_bidirectional(source, upstream, &agent_core::copy::ConnectionResult {})
-			.await
-			.map_err(ProxyError::UpstreamTCPProxy)?;
+		let stats = agent_core::copy::ConnectionResult {};
+		match idle_timeout {
+			Some(idle_timeout) => {
+				agent_core::copy::copy_bidirectional_with_idle_timeout(
+					source,
+					upstream,
+					&stats,
+					idle_timeout,
+				)
+				.await
+			},
+			None => agent_core::copy::copy_bidirectional(source, upstream, &stats).await,
+		}
+		.map_err(ProxyError::UpstreamTCPProxy)?;
 
 		let dur = format!("{}ms", start.elapsed().as_millis());
 		event!(
@@ -370,6 +370,45 @@ fn test_require_is_not_sufficient_when_allow_rules_exist() {
 	assert_matches!(rs.validate(&exec), true);
 }
 
+#[test]
+fn test_default_deny_requires_explicit_allow() {
+	// A gateway-level defaultDeny is an allow rule that never matches.
+	let default_deny = RuleSet::new(create_policy_set(vec!["false"]));
+	let req = req(json!({"role": "admin"}));
+	let mcp = tool_context("server", "increment");
+
+	// Without any other policy, every request is denied.
+	let mut ctx = ContextBuilder::new();
+	let rs = RuleSets::from(vec![default_deny.clone()]);
+	rs.register(&mut ctx);
+	let exec = cel::Executor::new_mcp(req.as_ref(), &mcp);
+	assert_matches!(rs.validate(&exec), false);
+
+	// A route-level allow rule that matches permits the request.
+	let route_allow = RuleSet::new(create_policy_set(vec![r#"jwt.role == "admin""#]));
+	let mut ctx = ContextBuilder::new();
+	let rs = RuleSets::from(vec![default_deny.clone(), route_allow]);
+	rs.register(&mut ctx);
+	let exec = cel::Executor::new_mcp(req.as_ref(), &mcp);
+	assert_matches!(rs.validate(&exec), true);
+
+	// A route-level allow rule that does not match leaves the request denied.
+	let route_allow = RuleSet::new(create_policy_set(vec![r#"jwt.role == "viewer""#]));
+	let mut ctx = ContextBuilder::new();
+	let rs = RuleSets::from(vec![default_deny.clone(), route_allow]);
+	rs.register(&mut ctx);
+	let exec = cel::Executor::new_mcp(req.as_ref(), &mcp);
+	assert_matches!(rs.validate(&exec), false);
+
+	// Route-level deny rules alone do not lift the default deny.
+	let route_deny = RuleSet::new(create_deny_policy_set(vec![r#"jwt.role == "viewer""#]));
+	let mut ctx = ContextBuilder::new();
+	let rs = RuleSets::from(vec![default_deny, route_deny]);
+	rs.register(&mut ctx);
+	let exec = cel::Executor::new_mcp(req.as_ref(), &mcp);
+	assert_matches!(rs.validate(&exec), false);
+}
+
 #[divan::bench]
 fn bench(b: Bencher) {
 	let policies = vec![r#"mcp.tool.name == "increment" && jwt.user.role == "admin""#];
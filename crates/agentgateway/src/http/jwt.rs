@@ -1,5 +1,5 @@
 // Inspired by https://github.com/cdriehuys/axum-jwks/blob/main/axum-jwks/src/jwks.rs (MIT license)
-use std::collections::{HashMap, HashSet};
+use std::collections::{BTreeMap, HashMap, HashSet};
 use std::str::FromStr;
 
 use ::cel::types::dynamic::DynamicType;
@@ -37,6 +37,9 @@ pub enum TokenError {
 	#[error("token uses the unknown key {0:?}")]
 	UnknownKeyId(String),
 
+	#[error("the token claim {0:?} is missing or does not have the required value")]
+	RequiredClaim(String),
+
 	#[error("failed to strip validated credentials from the request: {0}")]
 	CredentialRemoval(String),
 }
@@ -73,12 +76,19 @@ pub struct Jwt {
 	mode: Mode,
 	providers: Vec<Provider>,
 	location: AuthorizationLocation,
+	/// Locations tried in order when `location` carries no token.
+	fallback_locations: Vec<AuthorizationLocation>,
+	/// Keep the validated token on the request sent to the backend.
+	forward_token: bool,
 }
 
 #[derive(Clone)]
 pub struct Provider {
 	issuer: String,
 	keys: HashMap<String, Jwk>,
+	/// Claims a validated token must carry. An empty value only requires the claim to be present;
+	/// otherwise the claim must be a string equal to the value.
+	required_claims: BTreeMap<String, String>,
 }
 
 // TODO: can we give anything useful here?
@@ -93,11 +103,17 @@ impl serde::Serialize for Jwt {
 			mode: Mode,
 			providers: &'a Vec<Provider>,
 			location: &'a AuthorizationLocation,
+			#[serde(skip_serializing_if = "Vec::is_empty")]
+			fallback_locations: &'a Vec<AuthorizationLocation>,
+			#[serde(skip_serializing_if = "std::ops::Not::not")]
+			forward_token: bool,
 		}
 		Serde {
 			mode: self.mode,
 			providers: &self.providers,
 			location: &self.location,
+			fallback_locations: &self.fallback_locations,
+			forward_token: self.forward_token,
 		}
 		.serialize(serializer)
 	}
@@ -112,10 +128,13 @@ impl serde::Serialize for Provider {
 		pub struct Serde<'a> {
 			issuer: &'a str,
 			keys: Vec<&'a str>,
+			#[serde(skip_serializing_if = "BTreeMap::is_empty")]
+			required_claims: &'a BTreeMap<String, String>,
 		}
 		Serde {
 			issuer: &self.issuer,
 			keys: self.keys.keys().map(|x| x.as_str()).collect::<Vec<_>>(),
+			required_claims: &self.required_claims,
 		}
 		.serialize(serializer)
 	}
@@ -287,6 +306,8 @@ impl LocalJwtConfig {
 			mode,
 			providers,
 			location: authorization_location,
+			fallback_locations: Vec::new(),
+			forward_token: false,
 		})
 	}
 }
@@ -397,7 +418,33 @@ impl Provider {
 			);
 		}
 
-		Ok(Provider { issuer, keys })
+		Ok(Provider {
+			issuer,
+			keys,
+			required_claims: BTreeMap::new(),
+		})
+	}
+
+	/// Sets the claims a token must carry, checked after its signature is validated.
+	pub fn with_required_claims(mut self, required_claims: BTreeMap<String, String>) -> Provider {
+		self.required_claims = required_claims;
+		self
+	}
+
+	fn check_required_claims(&self, claims: &Map<String, Value>) -> Result<(), TokenError> {
+		for (name, want) in &self.required_claims {
+			let ok = match claims.get(name) {
+				None => false,
+				Some(_) if want.is_empty() => true,
+				Some(Value::String(got)) => got == want,
+				Some(_) => false,
+			};
+			if !ok {
+				debug!(claim = %name, "Token is missing a required claim.");
+				return Err(TokenError::RequiredClaim(name.clone()));
+			}
+		}
+		Ok(())
 	}
 }
 
@@ -411,8 +458,27 @@ impl Jwt {
 			mode,
 			providers,
 			location: authorization_location,
+			fallback_locations: Vec::new(),
+			forward_token: false,
 		}
 	}
+
+	/// Sets the locations tried, in order, when the primary location carries no token. Only the
+	/// location the token was read from is stripped once it is validated.
+	pub fn with_fallback_locations(mut self, locations: Vec<AuthorizationLocation>) -> Jwt {
+		self.fallback_locations = locations;
+		self
+	}
+
+	/// Sets whether the validated token is forwarded to the backend instead of being stripped.
+	pub fn with_forward_token(mut self, forward_token: bool) -> Jwt {
+		self.forward_token = forward_token;
+		self
+	}
+
+	fn locations(&self) -> impl Iterator<Item = &AuthorizationLocation> {
+		std::iter::once(&self.location).chain(self.fallback_locations.iter())
+	}
 }
 
 #[derive(Clone)]
@@ -484,7 +550,9 @@ impl<'de> Deserialize<'de> for Claims {
 
 impl Jwt {
 	pub fn expressions(&self) -> impl Iterator<Item = &crate::cel::Expression> {
-		self.location.expression().into_iter()
+		self
+			.locations()
+			.filter_map(|location| location.expression())
 	}
 
 	pub async fn apply(
@@ -492,7 +560,10 @@ impl Jwt {
 		log: Option<&mut RequestLog>,
 		req: &mut Request,
 	) -> Result<(), TokenError> {
-		let Some(token) = self.location.extract(req) else {
+		let Some((location, token)) = self
+			.locations()
+			.find_map(|location| location.extract(req).map(|token| (location, token)))
+		else {
 			// In strict mode, we require a token
 			if self.mode == Mode::Strict {
 				dtrace::pol_result!(
@@ -535,11 +606,12 @@ impl Jwt {
 		{
 			log.jwt_sub = Some(sub.to_string());
 		};
-		// Remove the token.
-		self
-			.location
-			.remove(req)
-			.map_err(|e| TokenError::CredentialRemoval(e.to_string()))?;
+		// Remove the token from the location it was read from, unless it is forwarded.
+		if !self.forward_token {
+			location
+				.remove(req)
+				.map_err(|e| TokenError::CredentialRemoval(e.to_string()))?;
+		}
 		// Insert the claims into extensions so we can reference it later
 		dtrace::pol_result!(
 			dtrace::Severity::Info,
@@ -564,10 +636,10 @@ impl Jwt {
 		})?;
 
 		// Search for the key across all providers
-		let key = self
+		let (provider, key) = self
 			.providers
 			.iter()
-			.find_map(|provider| provider.keys.get(kid))
+			.find_map(|provider| provider.keys.get(kid).map(|key| (provider, key)))
 			.ok_or_else(|| {
 				debug!(%kid, "Token refers to an unknown key.");
 
@@ -580,6 +652,7 @@ impl Jwt {
 
 				TokenError::Invalid(error)
 			})?;
+		provider.check_required_claims(&decoded_token.claims)?;
 
 		let claims = Claims {
 			inner: decoded_token.claims,
@@ -1,4 +1,4 @@
-use std::collections::HashSet;
+use std::collections::{BTreeMap, HashSet};
 
 use itertools::Itertools;
 use serde_json::json;
@@ -258,6 +258,8 @@ pub fn test_ed25519_jwt_validation() {
 		mode: Mode::Strict,
 		providers: vec![provider],
 		location: bearer_location(),
+		fallback_locations: vec![],
+		forward_token: false,
 	};
 	let now = std::time::SystemTime::now()
 		.duration_since(std::time::UNIX_EPOCH)
@@ -351,6 +353,8 @@ fn setup_test_jwt() -> (Jwt, &'static str, &'static str, &'static str) {
 			mode: Mode::Strict,
 			providers: vec![provider],
 			location: bearer_location(),
+			fallback_locations: vec![],
+			forward_token: false,
 		},
 		kid,
 		issuer,
@@ -441,6 +445,80 @@ pub fn test_jwt_rejections_table() {
 	assert!(matches!(res, Err(TokenError::UnknownKeyId(_))));
 }
 
+fn build_unsigned_token_with_claims(
+	kid: &str,
+	iss: &str,
+	aud: &str,
+	exp: u64,
+	claims: serde_json::Value,
+) -> String {
+	use base64::Engine as _;
+	use base64::engine::general_purpose::URL_SAFE_NO_PAD;
+	let header = json!({ "alg": "ES256", "kid": kid });
+	let mut payload = json!({ "iss": iss, "aud": aud, "exp": exp });
+	payload
+		.as_object_mut()
+		.unwrap()
+		.extend(claims.as_object().unwrap().clone());
+	let h = URL_SAFE_NO_PAD.encode(serde_json::to_vec(&header).unwrap());
+	let p = URL_SAFE_NO_PAD.encode(serde_json::to_vec(&payload).unwrap());
+	let s = URL_SAFE_NO_PAD.encode(b"sig");
+	format!("{h}.{p}.{s}")
+}
+
+// Required claims: a claim with a value must be a string equal to it, and a claim without one
+// must only be present
+#[test]
+pub fn test_validate_claims_required_claims() {
+	use std::time::{SystemTime, UNIX_EPOCH};
+
+	let (mut jwt, kid, issuer, allowed_aud) = setup_test_jwt();
+	jwt.providers = jwt
+		.providers
+		.into_iter()
+		.map(|p| {
+			p.with_required_claims(BTreeMap::from([
+				("azp".to_string(), "web".to_string()),
+				("role".to_string(), String::new()),
+			]))
+		})
+		.collect();
+	let exp = SystemTime::now()
+		.duration_since(UNIX_EPOCH)
+		.unwrap()
+		.as_secs()
+		+ 600;
+
+	let cases = [
+		(
+			"all present",
+			json!({ "azp": "web", "role": "admin" }),
+			None,
+		),
+		(
+			"value mismatch",
+			json!({ "azp": "cli", "role": "admin" }),
+			Some("azp"),
+		),
+		(
+			"non-string value",
+			json!({ "azp": ["web"], "role": "admin" }),
+			Some("azp"),
+		),
+		("missing claim", json!({ "azp": "web" }), Some("role")),
+	];
+	for (name, claims, expected) in cases {
+		let token = build_unsigned_token_with_claims(kid, issuer, allowed_aud, exp, claims);
+		match (jwt.validate_claims(&token), expected) {
+			(Ok(_), None) => {},
+			(Err(TokenError::RequiredClaim(claim)), Some(expected)) => {
+				assert_eq!(claim, expected, "{name}")
+			},
+			(other, _) => panic!("{name}: unexpected result {other:?}"),
+		}
+	}
+}
+
 // Strict mode: reject requests that are missing the Authorization header
 #[tokio::test]
 pub async fn test_apply_strict_missing_token() {
@@ -449,6 +527,8 @@ pub async fn test_apply_strict_missing_token() {
 		mode: super::Mode::Strict,
 		providers: vec![],
 		location: bearer_location(),
+		fallback_locations: vec![],
+		forward_token: false,
 	};
 
 	// Minimal Request without Authorization header
@@ -469,6 +549,8 @@ pub async fn test_apply_permissive_no_token_ok() {
 		mode: Mode::Permissive,
 		providers: base.providers.clone(),
 		location: bearer_location(),
+		fallback_locations: vec![],
+		forward_token: false,
 	};
 	let mut req = crate::http::Request::new(crate::http::Body::empty());
 	let mut log = make_min_req_log();
@@ -485,6 +567,8 @@ pub async fn test_apply_permissive_invalid_token_ok_and_keeps_header() {
 		mode: Mode::Permissive,
 		providers: base.providers.clone(),
 		location: bearer_location(),
+		fallback_locations: vec![],
+		forward_token: false,
 	};
 	let mut req = crate::http::Request::new(crate::http::Body::empty());
 	req.headers_mut().insert(
@@ -514,6 +598,8 @@ pub async fn test_apply_permissive_valid_token_inserts_claims_and_removes_header
 		mode: Mode::Permissive,
 		providers: base.providers.clone(),
 		location: bearer_location(),
+		fallback_locations: vec![],
+		forward_token: false,
 	};
 	let now = SystemTime::now()
 		.duration_since(UNIX_EPOCH)
@@ -545,6 +631,8 @@ pub async fn test_apply_optional_no_token_ok() {
 		mode: Mode::Optional,
 		providers: base.providers.clone(),
 		location: bearer_location(),
+		fallback_locations: vec![],
+		forward_token: false,
 	};
 	let mut req = crate::http::Request::new(crate::http::Body::empty());
 	let mut log = make_min_req_log();
@@ -561,6 +649,8 @@ pub async fn test_apply_optional_invalid_token_err() {
 		mode: Mode::Optional,
 		providers: base.providers.clone(),
 		location: bearer_location(),
+		fallback_locations: vec![],
+		forward_token: false,
 	};
 	let mut req = crate::http::Request::new(crate::http::Body::empty());
 	req.headers_mut().insert(
@@ -581,6 +671,8 @@ pub async fn test_apply_optional_valid_token_inserts_claims_and_removes_header()
 		mode: Mode::Optional,
 		providers: base.providers.clone(),
 		location: bearer_location(),
+		fallback_locations: vec![],
+		forward_token: false,
 	};
 	let now = SystemTime::now()
 		.duration_since(UNIX_EPOCH)
@@ -615,6 +707,8 @@ pub async fn test_apply_query_parameter_token_inserts_claims_and_removes_query_p
 		location: crate::http::auth::AuthorizationLocation::QueryParameter {
 			name: "token".into(),
 		},
+		fallback_locations: vec![],
+		forward_token: false,
 	};
 	let now = SystemTime::now()
 		.duration_since(UNIX_EPOCH)
@@ -632,6 +726,130 @@ pub async fn test_apply_query_parameter_token_inserts_claims_and_removes_query_p
 	assert!(req.extensions().get::<super::Claims>().is_some());
 }
 
+#[tokio::test]
+pub async fn test_apply_forward_token_keeps_header() {
+	use std::time::{SystemTime, UNIX_EPOCH};
+
+	let (base, kid, issuer, allowed_aud) = setup_test_jwt();
+	let jwt = base.clone().with_forward_token(true);
+	let now = SystemTime::now()
+		.duration_since(UNIX_EPOCH)
+		.unwrap()
+		.as_secs();
+	let token = build_unsigned_token(kid, issuer, allowed_aud, now + 600);
+	let mut req = crate::http::Request::new(crate::http::Body::empty());
+	req.headers_mut().insert(
+		crate::http::header::AUTHORIZATION,
+		crate::http::HeaderValue::from_str(&format!("Bearer {token}")).unwrap(),
+	);
+	let mut log = make_min_req_log();
+	let res = jwt.apply(Some(&mut log), &mut req).await;
+	assert!(res.is_ok());
+	assert_eq!(
+		req
+			.headers()
+			.get(crate::http::header::AUTHORIZATION)
+			.and_then(|v| v.to_str().ok()),
+		Some(format!("Bearer {token}").as_str())
+	);
+	assert!(req.extensions().get::<super::Claims>().is_some());
+}
+
+fn cookie_then_query_jwt(base: &Jwt) -> Jwt {
+	Jwt::from_providers(
+		base.providers.clone(),
+		Mode::Strict,
+		crate::http::auth::AuthorizationLocation::Cookie {
+			name: "session".into(),
+		},
+	)
+	.with_fallback_locations(vec![
+		crate::http::auth::AuthorizationLocation::QueryParameter {
+			name: "token".into(),
+		},
+	])
+}
+
+#[tokio::test]
+pub async fn test_apply_token_sources_reads_cookie() {
+	use std::time::{SystemTime, UNIX_EPOCH};
+
+	let (base, kid, issuer, allowed_aud) = setup_test_jwt();
+	let jwt = cookie_then_query_jwt(&base);
+	let now = SystemTime::now()
+		.duration_since(UNIX_EPOCH)
+		.unwrap()
+		.as_secs();
+	let token = build_unsigned_token(kid, issuer, allowed_aud, now + 600);
+	let mut req = crate::http::Request::new(crate::http::Body::empty());
+	req.headers_mut().insert(
+		crate::http::header::COOKIE,
+		crate::http::HeaderValue::from_str(&format!("session={token}; theme=dark")).unwrap(),
+	);
+	let mut log = make_min_req_log();
+	let res = jwt.apply(Some(&mut log), &mut req).await;
+	assert!(res.is_ok());
+	assert!(crate::http::read_request_cookie(&req, "session").is_none());
+	assert!(crate::http::read_request_cookie(&req, "theme").is_some());
+	assert!(req.extensions().get::<super::Claims>().is_some());
+}
+
+#[tokio::test]
+pub async fn test_apply_token_sources_falls_back_to_query_parameter() {
+	use std::time::{SystemTime, UNIX_EPOCH};
+
+	let (base, kid, issuer, allowed_aud) = setup_test_jwt();
+	let jwt = cookie_then_query_jwt(&base);
+	let now = SystemTime::now()
+		.duration_since(UNIX_EPOCH)
+		.unwrap()
+		.as_secs();
+	let token = build_unsigned_token(kid, issuer, allowed_aud, now + 600);
+	let mut req = crate::http::Request::new(crate::http::Body::empty());
+	*req.uri_mut() = format!("http://example.com/?token={token}&keep=yes")
+		.parse()
+		.unwrap();
+	let mut log = make_min_req_log();
+	let res = jwt.apply(Some(&mut log), &mut req).await;
+	assert!(res.is_ok());
+	assert_eq!(req.uri().to_string(), "http://example.com/?keep=yes");
+	assert!(req.extensions().get::<super::Claims>().is_some());
+}
+
+#[tokio::test]
+pub async fn test_apply_token_sources_uses_first_present_source() {
+	use std::time::{SystemTime, UNIX_EPOCH};
+
+	let (base, kid, issuer, allowed_aud) = setup_test_jwt();
+	let jwt = cookie_then_query_jwt(&base);
+	let now = SystemTime::now()
+		.duration_since(UNIX_EPOCH)
+		.unwrap()
+		.as_secs();
+	let token = build_unsigned_token(kid, issuer, allowed_aud, now + 600);
+	let mut req = crate::http::Request::new(crate::http::Body::empty());
+	req.headers_mut().insert(
+		crate::http::header::COOKIE,
+		crate::http::HeaderValue::from_str(&format!("session={token}")).unwrap(),
+	);
+	// The query parameter is not a valid token, but the cookie is tried first.
+	*req.uri_mut() = "http://example.com/?token=invalid-token".parse().unwrap();
+	let mut log = make_min_req_log();
+	let res = jwt.apply(Some(&mut log), &mut req).await;
+	assert!(res.is_ok());
+	assert!(crate::http::read_request_cookie(&req, "session").is_none());
+	assert_eq!(
+		req.uri().to_string(),
+		"http://example.com/?token=invalid-token"
+	);
+	assert!(req.extensions().get::<super::Claims>().is_some());
+
+	// Without any source present, strict mode rejects the request.
+	let mut req = crate::http::Request::new(crate::http::Body::empty());
+	let res = jwt.apply(Some(&mut log), &mut req).await;
+	assert!(matches!(res, Err(TokenError::Missing)));
+}
+
 fn make_min_req_log() -> crate::telemetry::log::RequestLog {
 	use std::net::{IpAddr, Ipv4Addr, SocketAddr};
 	use std::sync::Arc;
@@ -747,6 +965,8 @@ fn setup_test_multi_jwt() -> (Jwt, ProviderInfo, ProviderInfo) {
 			mode: Mode::Strict,
 			providers: vec![provider1, provider2],
 			location: bearer_location(),
+			fallback_locations: vec![],
+			forward_token: false,
 		},
 		(kid1, issuer1, aud1),
 		(kid2, issuer2, aud2),
@@ -842,6 +1062,8 @@ pub fn test_empty_required_claims_accepts_token_without_exp() {
 		mode: Mode::Strict,
 		providers: vec![provider],
 		location: bearer_location(),
+		fallback_locations: vec![],
+		forward_token: false,
 	};
 
 	let token = build_unsigned_token_without_exp(kid, issuer, aud);
@@ -901,6 +1123,8 @@ pub fn test_default_required_claims_rejects_token_without_exp() {
 		mode: Mode::Strict,
 		providers: vec![provider],
 		location: bearer_location(),
+		fallback_locations: vec![],
+		forward_token: false,
 	};
 
 	let token = build_unsigned_token_without_exp(kid, issuer, aud);
@@ -958,6 +1182,8 @@ pub fn test_empty_required_claims_still_rejects_expired_tokens() {
 		mode: Mode::Strict,
 		providers: vec![provider],
 		location: bearer_location(),
+		fallback_locations: vec![],
+		forward_token: false,
 	};
 
 	let token = build_unsigned_token_with_expired_exp(kid, issuer, aud);
@@ -1015,6 +1241,8 @@ pub fn test_required_claims_with_nbf_rejects_missing_nbf() {
 		mode: Mode::Strict,
 		providers: vec![provider],
 		location: bearer_location(),
+		fallback_locations: vec![],
+		forward_token: false,
 	};
 
 	// Token with exp but without nbf should be rejected when nbf is required
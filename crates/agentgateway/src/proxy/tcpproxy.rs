@@ -211,6 +211,15 @@ impl TCPProxy {
 				source: connection,
 				target: backend_call.target,
 				transport,
+				// A route-level idle timeout overrides the one from the backend TCP settings.
+				idle_timeout: match &backend_call.backend_policies.tcp_idle_timeout {
+					Some(t) => Some(t.timeout),
+					None => backend_call
+						.backend_policies
+						.tcp
+						.as_ref()
+						.and_then(|tcp| tcp.idle_timeout),
+				},
 			})
 			.await?;
 		Ok(())
@@ -590,4 +590,123 @@ frontendPolicies:
 		replace_config(&path, "third").await;
 		wait_for_access_log_remove(&config, &stores, "third").await;
 	}
+
+	#[tokio::test]
+	async fn file_config_keeps_previous_state_after_malformed_update() {
+		let dir = tempfile::tempdir().unwrap();
+		let path = dir.path().join("config.yaml");
+		fs_err::tokio::write(&path, local_config("first"))
+			.await
+			.unwrap();
+
+		let mut config = test_config();
+		config.xds.local_config = Some(ConfigSource::File(path.clone()));
+		let config = Arc::new(config);
+		let stores = test_stores();
+		let mut registry = prometheus_client::registry::Registry::default();
+		let metrics = Arc::new(agent_xds::Metrics::new(&mut registry));
+		let client = test_client();
+		let resource_manager = crate::resource_manager::ResourceManager::new(client.clone()).unwrap();
+		let local_client = LocalClient {
+			config: config.clone(),
+			cfg: ConfigSource::File(path.clone()),
+			config_resource_store: None,
+			stores: stores.clone(),
+			client,
+			resource_manager,
+			gateway: config.gateway(),
+			metrics: metrics.clone(),
+		};
+
+		local_client.run().await.unwrap();
+		wait_for_access_log_remove(&config, &stores, "first").await;
+
+		// A ConfigMap update is swapped in with a rename; a malformed one must be
+		// reported without dropping the previously applied config.
+		let replacement = path.with_extension("malformed.tmp");
+		fs_err::tokio::write(&replacement, "frontendPolicies:\n  accessLog: [\n")
+			.await
+			.unwrap();
+		fs_err::rename(&replacement, &path).unwrap();
+		tokio::time::timeout(Duration::from_secs(5), async {
+			while metrics.config_synchronized.get() != 0 {
+				tokio::time::sleep(Duration::from_millis(10)).await;
+			}
+		})
+		.await
+		.expect("malformed config should be reported as unsynchronized");
+		wait_for_access_log_remove(&config, &stores, "first").await;
+
+		replace_config(&path, "second").await;
+		wait_for_access_log_remove(&config, &stores, "second").await;
+		assert_eq!(metrics.config_synchronized.get(), 1);
+	}
+
+	fn tracing_config(remove_field: &str) -> String {
+		format!(
+			r#"
+frontendPolicies:
+  tracing:
+    host: localhost:4317
+    remove:
+    - {remove_field}
+"#
+		)
+	}
+
+	async fn wait_for_tracing_remove(config: &crate::Config, stores: &Stores, remove_field: &str) {
+		tokio::time::timeout(Duration::from_secs(5), async {
+			loop {
+				let frontend = stores.binds.read().frontend_policies(config.gateway_ref());
+				if frontend
+					.tracing
+					.as_ref()
+					.is_some_and(|tracing| tracing.config.remove.iter().any(|f| f == remove_field))
+				{
+					return;
+				}
+				tokio::time::sleep(Duration::from_millis(10)).await;
+			}
+		})
+		.await
+		.unwrap_or_else(|_| panic!("timed out waiting for tracing remove {remove_field}"));
+	}
+
+	#[tokio::test]
+	async fn file_config_reloads_frontend_tracing() {
+		let dir = tempfile::tempdir().unwrap();
+		let path = dir.path().join("config.yaml");
+		fs_err::tokio::write(&path, tracing_config("first"))
+			.await
+			.unwrap();
+
+		let mut config = test_config();
+		config.xds.local_config = Some(ConfigSource::File(path.clone()));
+		let config = Arc::new(config);
+		let stores = test_stores();
+		let mut registry = prometheus_client::registry::Registry::default();
+		let metrics = Arc::new(agent_xds::Metrics::new(&mut registry));
+		let client = test_client();
+		let resource_manager = crate::resource_manager::ResourceManager::new(client.clone()).unwrap();
+		let local_client = LocalClient {
+			config: config.clone(),
+			cfg: ConfigSource::File(path.clone()),
+			config_resource_store: None,
+			stores: stores.clone(),
+			client,
+			resource_manager,
+			gateway: config.gateway(),
+			metrics,
+		};
+
+		local_client.run().await.unwrap();
+		wait_for_tracing_remove(&config, &stores, "first").await;
+
+		let replacement = path.with_extension("tracing.tmp");
+		fs_err::tokio::write(&replacement, tracing_config("second"))
+			.await
+			.unwrap();
+		fs_err::rename(&replacement, &path).unwrap();
+		wait_for_tracing_remove(&config, &stores, "second").await;
+	}
 }
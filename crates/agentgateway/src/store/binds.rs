@@ -246,6 +246,7 @@ pub struct BackendPolicies {
 
 	pub http: Option<types::backend::HTTP>,
 	pub tcp: Option<types::backend::TCP>,
+	pub tcp_idle_timeout: Option<types::backend::TCPIdleTimeout>,
 	pub tunnel: Option<types::backend::Tunnel>,
 
 	pub request_header_modifier: Option<filters::HeaderModifier>,
@@ -295,6 +296,7 @@ impl BackendPolicies {
 			ext_authz: other.ext_authz.or(self.ext_authz),
 			http: other.http.or(self.http),
 			tcp: other.tcp.or(self.tcp),
+			tcp_idle_timeout: other.tcp_idle_timeout.or(self.tcp_idle_timeout),
 			tunnel: other.tunnel.or(self.tunnel),
 			request_header_modifier: other
 				.request_header_modifier
@@ -1186,6 +1188,9 @@ impl Store {
 				BackendTrafficPolicy::TCP(p) => {
 					pol.tcp.get_or_insert_with(|| p.clone());
 				},
+				BackendTrafficPolicy::TCPIdleTimeout(p) => {
+					pol.tcp_idle_timeout.get_or_insert_with(|| p.clone());
+				},
 				BackendTrafficPolicy::Tunnel(p) => {
 					pol.tunnel.get_or_insert_with(|| p.clone());
 				},
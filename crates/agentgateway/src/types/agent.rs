@@ -2683,6 +2683,8 @@ pub enum BackendTrafficPolicy {
 	HTTP(backend::HTTP),
 	#[serde(rename = "tcp")]
 	TCP(backend::TCP),
+	#[serde(rename = "tcpIdleTimeout")]
+	TCPIdleTimeout(backend::TCPIdleTimeout),
 	Tunnel(backend::Tunnel),
 	#[serde(rename = "backendTLS")]
 	BackendTLS(http::backendtls::BackendTLS),
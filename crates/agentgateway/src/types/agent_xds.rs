@@ -1814,7 +1814,19 @@ fn backend_policy_from_proto(
 				.as_ref()
 				.map(types::agent::KeepaliveConfig::from)
 				.unwrap_or_default(),
+			idle_timeout: btcp
+				.idle_timeout
+				.map(convert_duration)
+				.filter(|d| !d.is_zero()),
 		}),
+		Some(bps::Kind::TcpIdleTimeout(t)) => {
+			BackendTrafficPolicy::TCPIdleTimeout(backend::TCPIdleTimeout {
+				timeout: t
+					.timeout
+					.map(convert_duration)
+					.ok_or(ProtoError::MissingRequiredField)?,
+			})
+		},
 		Some(bps::Kind::BackendTunnel(bt)) => BackendTrafficPolicy::Tunnel(backend::Tunnel {
 			proxy: Arc::new(resolve_simple_reference(bt.proxy.as_ref())),
 		}),
@@ -2095,29 +2107,55 @@ fn traffic_policy_from_proto(
 							required_claims: vo.required_claims.iter().cloned().collect(),
 						})
 						.unwrap_or_default();
-					Ok(jwt_provider_from_inline_jwks_or_warn(
-						diagnostics,
-						"JWT policy",
-						jwks_json,
-						p.issuer.clone(),
-						audiences,
-						jwt_validation_options,
-					))
+					let required_claims = p
+						.required_claims
+						.iter()
+						.map(|(k, v)| (k.clone(), v.clone()))
+						.collect();
+					Ok(
+						jwt_provider_from_inline_jwks_or_warn(
+							diagnostics,
+							"JWT policy",
+							jwks_json,
+							p.issuer.clone(),
+							audiences,
+							jwt_validation_options,
+						)
+						.map(|provider| provider.with_required_claims(required_claims)),
+					)
 				})
 				.collect::<Result<Vec<_>, _>>()?
 				.into_iter()
 				.flatten()
 				.collect();
-			let jwt_auth = http::jwt::Jwt::from_providers(
-				providers,
-				mode,
+			// token_sources, when set, replaces authorization_location: the first source is the
+			// primary location and the rest are tried in order after it.
+			let mut locations = jwt
+				.token_sources
+				.iter()
+				.enumerate()
+				.map(|(idx, source)| {
+					authorization_location(
+						diagnostics,
+						format!("jwtAuthentication.tokenSources[{idx}].expression"),
+						Some(source),
+						http::auth::AuthorizationLocation::bearer_header(),
+					)
+				})
+				.collect::<Result<Vec<_>, _>>()?;
+			let location = if locations.is_empty() {
 				authorization_location(
 					diagnostics,
 					"jwtAuthentication.authorizationLocation.expression",
 					jwt.authorization_location.as_ref(),
 					http::auth::AuthorizationLocation::bearer_header(),
-				)?,
-			);
+				)?
+			} else {
+				locations.remove(0)
+			};
+			let jwt_auth = http::jwt::Jwt::from_providers(providers, mode, location)
+				.with_fallback_locations(locations)
+				.with_forward_token(jwt.forward_token);
 			let mcp = match &jwt.mcp {
 				Some(mcp) => {
 					if jwt.providers.len() != 1 {
@@ -77,6 +77,24 @@ pub struct TCP {
 	#[serde(with = "crate::serdes::serde_dur")]
 	#[cfg_attr(feature = "schema", schemars(with = "String"))]
 	pub connect_timeout: Duration,
+	/// Close a TCP route connection after no data has been sent or received for this long.
+	#[serde(
+		default,
+		skip_serializing_if = "Option::is_none",
+		with = "serde_dur_option"
+	)]
+	#[cfg_attr(feature = "schema", schemars(with = "Option<String>"))]
+	pub idle_timeout: Option<Duration>,
+}
+
+/// Idle timeout for connections proxied by a TCP route, set by route-level policies. Unlike [`TCP`],
+/// it leaves the keepalive and connect timeout of less specific policies in place.
+#[apply(schema!)]
+pub struct TCPIdleTimeout {
+	/// Close a TCP route connection after no data has been sent or received for this long.
+	#[serde(with = "crate::serdes::serde_dur")]
+	#[cfg_attr(feature = "schema", schemars(with = "String"))]
+	pub timeout: Duration,
 }
 
 impl Default for TCP {
@@ -84,6 +102,7 @@ impl Default for TCP {
 		Self {
 			keepalives: Default::default(),
 			connect_timeout: defaults::connect_timeout(),
+			idle_timeout: None,
 		}
 	}
 }
@@ -4,7 +4,9 @@ use std::marker::PhantomPinned;
 use std::mem::MaybeUninit;
 use std::net::SocketAddr;
 use std::pin::Pin;
+use std::sync::atomic::{AtomicU64, Ordering};
 use std::task::{Context, Poll, ready};
+use std::time::Duration;
 
 use bytes::{Buf, BufMut, Bytes, BytesMut};
 use pin_project_lite::pin_project;
@@ -12,6 +14,7 @@ use tokio::io;
 use tokio::io::{AsyncRead, AsyncWrite, ReadBuf};
 use tokio::net::TcpStream;
 use tokio::net::tcp::{OwnedReadHalf, OwnedWriteHalf};
+use tokio::time::Instant;
 use tracing::trace;
 
 // BufferedSplitter is a trait to expose splitting an IO object into a buffered reader and a writer
@@ -147,6 +150,9 @@ pub enum CopyError {
 
 	#[error("attempted recursive call to ourselves")]
 	SelfCall,
+
+	#[error("connection idle for {0:?}")]
+	IdleTimeout(Duration),
 }
 
 // Initially we create a 1k buffer for each connection. Note currently there are 3 buffers per connection.
@@ -180,6 +186,76 @@ pub async fn copy_bidirectional<A, B>(
 	upstream: B,
 	stats: &ConnectionResult,
 ) -> Result<(), CopyError>
+where
+	A: BufferedSplitter,
+	B: BufferedSplitter,
+{
+	copy_bidirectional_tracked(downstream, upstream, stats, None).await
+}
+
+/// Like copy_bidirectional, but closes both connections once no data has been copied in either
+/// direction for idle_timeout.
+pub async fn copy_bidirectional_with_idle_timeout<A, B>(
+	downstream: A,
+	upstream: B,
+	stats: &ConnectionResult,
+	idle_timeout: Duration,
+) -> Result<(), CopyError>
+where
+	A: BufferedSplitter,
+	B: BufferedSplitter,
+{
+	let activity = Activity::new();
+	let copy = copy_bidirectional_tracked(downstream, upstream, stats, Some(&activity));
+	let idle = async {
+		loop {
+			let deadline = activity.last() + idle_timeout;
+			if deadline <= Instant::now() {
+				return;
+			}
+			tokio::time::sleep_until(deadline).await;
+		}
+	};
+	tokio::select! {
+		res = copy => res,
+		_ = idle => {
+			trace!(?idle_timeout, "closing idle connection");
+			Err(CopyError::IdleTimeout(idle_timeout))
+		},
+	}
+}
+
+// Activity records when data was last copied in either direction.
+struct Activity {
+	start: Instant,
+	// Milliseconds since start.
+	last: AtomicU64,
+}
+
+impl Activity {
+	fn new() -> Self {
+		Activity {
+			start: Instant::now(),
+			last: AtomicU64::new(0),
+		}
+	}
+
+	fn touch(&self) {
+		let elapsed = self.start.elapsed().as_millis() as u64;
+		self.last.store(elapsed, Ordering::Relaxed);
+	}
+
+	fn last(&self) -> Instant {
+		self.start + Duration::from_millis(self.last.load(Ordering::Relaxed))
+	}
+}
+
+async fn copy_bidirectional_tracked<A, B>(
+	downstream: A,
+	upstream: B,
+	stats: &ConnectionResult,
+	activity: Option<&Activity>,
+) -> Result<(), CopyError>
 where
 	A: BufferedSplitter,
 	B: BufferedSplitter,
@@ -195,8 +271,8 @@ where
 				_ => e.into(),
 			}))
 		};
-		let res =
-			ignore_io_errors(copy_buf(&mut rd, &mut wu, stats, false).await).map_err(translate_error);
+		let res = ignore_io_errors(copy_buf(&mut rd, &mut wu, stats, activity, false).await)
+			.map_err(translate_error);
 		trace!(?res, "send");
 		ignore_shutdown_errors(shutdown(&mut wu).await)
 			.map_err(translate_error)
@@ -212,8 +288,8 @@ where
 				_ => e.into(),
 			}))
 		};
-		let res =
-			ignore_io_errors(copy_buf(&mut ru, &mut wd, stats, true).await).map_err(translate_error);
+		let res = ignore_io_errors(copy_buf(&mut ru, &mut wd, stats, activity, true).await)
+			.map_err(translate_error);
 		trace!(?res, "receive");
 		ignore_shutdown_errors(shutdown(&mut wd).await)
 			.map_err(translate_error)
@@ -274,6 +350,7 @@ struct CopyBuf<'a, R: ?Sized, W: ?Sized> {
 	writer: &'a mut W,
 	buf: Option<Bytes>,
 	metrics: &'a ConnectionResult,
+	activity: Option<&'a Activity>,
 	amt: u64,
 }
 
@@ -281,6 +358,7 @@ async fn copy_buf<'a, R, W>(
 	reader: &'a mut R,
 	writer: &'a mut W,
 	metrics: &ConnectionResult,
+	activity: Option<&Activity>,
 	is_send: bool,
 ) -> std::io::Result<u64>
 where
@@ -293,6 +371,7 @@ where
 		writer,
 		buf: None,
 		metrics,
+		activity,
 		amt: 0,
 	}
 	.await
@@ -342,6 +421,9 @@ where
 			} else {
 				me.metrics.increment_recv(i as u64);
 			}
+			if let Some(activity) = me.activity {
+				activity.touch();
+			}
 			let old = self.amt;
 			self.amt += i as u64;
 
@@ -487,3 +569,52 @@ pub fn poll_read_buf<T: AsyncRead + ?Sized, B: BufMut>(
 
 	Poll::Ready(Ok(n))
 }
+
+#[cfg(test)]
+mod tests {
+	use tokio::io::{AsyncReadExt, AsyncWriteExt};
+
+	use super::*;
+
+	#[tokio::test]
+	async fn idle_timeout_closes_idle_connection() {
+		let (downstream, _client) = tokio::io::duplex(64);
+		let (upstream, _server) = tokio::io::duplex(64);
+		let res = copy_bidirectional_with_idle_timeout(
+			downstream,
+			upstream,
+			&ConnectionResult {},
+			Duration::from_millis(50),
+		)
+		.await;
+		assert!(matches!(res, Err(CopyError::IdleTimeout(_))), "{res:?}");
+	}
+
+	#[tokio::test]
+	async fn idle_timeout_resets_on_activity() {
+		let (downstream, mut client) = tokio::io::duplex(64);
+		let (upstream, mut server) = tokio::io::duplex(64);
+		let copy = tokio::spawn(async move {
+			copy_bidirectional_with_idle_timeout(
+				downstream,
+				upstream,
+				&ConnectionResult {},
+				Duration::from_millis(200),
+			)
+			.await
+		});
+		// Keep the connection busy for longer than the idle timeout.
+		let mut buf = [0u8; 4];
+		for _ in 0..6 {
+			tokio::time::sleep(Duration::from_millis(50)).await;
+			client.write_all(b"ping").await.unwrap();
+			server.read_exact(&mut buf).await.unwrap();
+			assert_eq!(&buf, b"ping");
+		}
+		assert!(!copy.is_finished());
+		drop(client);
+		drop(server);
+		let res = copy.await.unwrap();
+		assert!(!matches!(res, Err(CopyError::IdleTimeout(_))), "{res:?}");
+	}
+}